@@ -92,7 +92,6 @@ func run(cmd *cobra.Command, argv []string) error {
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Write the header row:
 	err = table.WriteHeaders()
@@ -135,5 +134,5 @@ func run(cmd *cobra.Command, argv []string) error {
 		index++
 	}
 
-	return nil
+	return table.Close()
 }
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/cmd/ocm/alias/list"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/alias/set"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/alias/unset"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "alias COMMAND",
+	Short: "Manage resource aliases",
+	Long: "Manage the resource aliases used by the 'get', 'post', 'patch' and 'delete' commands.\n\n" +
+		"An alias is a name for a path, for example 'clusters' for '/api/clusters_mgmt/v1/clusters'. " +
+		"The path can contain numbered placeholders like '{1}' and '{2}', that are replaced by the " +
+		"arguments that follow the alias in the command line. The aliases defined by the user are " +
+		"stored in the configuration of the active profile, and take precedence over the built-in " +
+		"aliases with the same name.",
+	Example: "  # Define an alias for the machine pools of a cluster:\n" +
+		"  ocm alias set mp /api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}\n\n" +
+		"  # Use it to get the 'worker' machine pool of cluster '123':\n" +
+		"  ocm get mp 123 worker",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(list.Cmd)
+	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(unset.Cmd)
+}
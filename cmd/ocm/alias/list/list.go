@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/urls"
+)
+
+var args struct {
+	output string
+	user   bool
+}
+
+var Cmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "Lists the resource aliases",
+	Long: "Lists the built-in resource aliases and the aliases defined by the user, with the " +
+		"templates of their paths.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+	fs.BoolVar(
+		&args.user,
+		"user",
+		false,
+		"List only the aliases defined by the user.",
+	)
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Load the aliases:
+	aliases, err := urls.Aliases()
+	if err != nil {
+		return fmt.Errorf("Can't load aliases: %v", err)
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("aliases").
+		Columns("name, template, source").
+		Value("source", func(alias *urls.Alias) string {
+			if alias.BuiltIn {
+				return "built-in"
+			}
+			return "user"
+		}).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, alias := range aliases {
+		if args.user && alias.BuiltIn {
+			continue
+		}
+		err = table.WriteObject(alias)
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package set
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/urls"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "set NAME TEMPLATE",
+	Short: "Defines a resource alias",
+	Long: "Defines a resource alias, or replaces it if it already exists. The template is the " +
+		"path of the resource, and can contain numbered placeholders like '{1}' and '{2}'. " +
+		"The alias is stored in the configuration of the active profile.",
+	Example: "  ocm alias set mp /api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}",
+	Args:    cobra.ExactArgs(2),
+	RunE:    run,
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	name, template := argv[0], argv[1]
+	err := urls.CheckAlias(name, template)
+	if err != nil {
+		return err
+	}
+
+	// Load the configuration:
+	cfg, err := config.LoadStored()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+
+	// Create an empty configuration if the configuration file doesn't exist:
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	// Add the alias and save the configuration:
+	if cfg.Aliases == nil {
+		cfg.Aliases = map[string]string{}
+	}
+	cfg.Aliases[name] = template
+	err = config.SaveStored(cfg)
+	if err != nil {
+		return fmt.Errorf("Can't save config file: %v", err)
+	}
+
+	return nil
+}
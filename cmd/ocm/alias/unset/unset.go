@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unset
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/urls"
+)
+
+var Cmd = &cobra.Command{
+	Use:               "unset NAME",
+	Short:             "Removes a resource alias",
+	Long:              "Removes a resource alias defined by the user. Built-in aliases can't be removed.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              run,
+	ValidArgsFunction: completeUserAliases,
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	name := argv[0]
+
+	// Load the configuration:
+	cfg, err := config.LoadStored()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if cfg == nil || cfg.Aliases[name] == "" {
+		return fmt.Errorf("Alias '%s' isn't defined by the user", name)
+	}
+
+	// Remove the alias and save the configuration:
+	delete(cfg.Aliases, name)
+	if len(cfg.Aliases) == 0 {
+		cfg.Aliases = nil
+	}
+	err = config.SaveStored(cfg)
+	if err != nil {
+		return fmt.Errorf("Can't save config file: %v", err)
+	}
+
+	return nil
+}
+
+// completeUserAliases completes the argument with the names of the aliases defined by the user.
+func completeUserAliases(cmd *cobra.Command, args []string,
+	toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	aliases, err := urls.UserAliases()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiresources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/arguments"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/schema"
+)
+
+var args struct {
+	output  string
+	service string
+	all     bool
+}
+
+var Cmd = &cobra.Command{
+	Use:   "api-resources",
+	Short: "List the resources of the API",
+	Long: "List the collections of the services of the API, with their paths and the methods " +
+		"that they support. The information is extracted from the specifications of the " +
+		"services included in the tool, so it doesn't require a connection to the server.",
+	Example: "  # List the collections of the clusters service:\n" +
+		"  ocm api-resources --service clusters_mgmt\n\n" +
+		"  # List all the paths, including items and actions:\n" +
+		"  ocm api-resources --all",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+	fs.StringVar(
+		&args.service,
+		"service",
+		"",
+		"List only the resources of the given service, for example 'clusters_mgmt'.",
+	)
+	fs.BoolVar(
+		&args.all,
+		"all",
+		false,
+		"List all the paths, not only the collections.",
+	)
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Load the resources:
+	catalog, err := arguments.LoadCatalog()
+	if err != nil {
+		return fmt.Errorf("Can't load API specifications: %v", err)
+	}
+	resources := catalog.Resources()
+
+	// Check that the service exists, as otherwise the result would be silently empty:
+	if args.service != "" {
+		services := map[string]bool{}
+		for _, resource := range resources {
+			services[resource.Service] = true
+		}
+		if !services[args.service] {
+			names := make([]string, 0, len(services))
+			for name := range services {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return fmt.Errorf(
+				"Unknown service '%s', valid services are %s",
+				args.service, strings.Join(names, ", "),
+			)
+		}
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("api_resources").
+		Columns("service, version, path, methods").
+		Value("methods", func(resource *schema.Resource) string {
+			return strings.Join(resource.Methods, ",")
+		}).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, resource := range resources {
+		if args.service != "" && resource.Service != args.service {
+			continue
+		}
+		if !args.all && !resource.Collection {
+			continue
+		}
+		err = table.WriteObject(resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
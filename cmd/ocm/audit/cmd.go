@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/cmd/ocm/audit/log"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "audit COMMAND",
+	Short: "Query the audit log",
+	Long: "Query the local audit log, that contains a record of each request that changed " +
+		"something in the server, like the ones sent by 'ocm delete', 'ocm patch', 'ocm edit " +
+		"cluster' or 'ocm hibernate cluster'.\n\n" +
+		"The audit log is disabled by default. To enable it set the 'audit_log' setting to the " +
+		"name of the file where the records should be appended. Each record contains the time, " +
+		"the user from the access token, the command line, the method, path and status of the " +
+		"request and the identifier assigned to it by the server.",
+	Example: "  # Enable the audit log:\n" +
+		"  ocm config set audit_log ~/.config/ocm/audit.jsonl\n\n" +
+		"  # Show the requests sent during the last day:\n" +
+		"  ocm audit log --since 24h",
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(log.Cmd)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/audit"
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+)
+
+var args struct {
+	output string
+	file   string
+	since  string
+	user   string
+	method string
+	path   string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "log",
+	Short: "Show the records of the audit log",
+	Long: "Show the records of the audit log, optionally only the ones sent since a given " +
+		"time, by a given user, or with a given method or path.",
+	Example: "  # Show the clusters deleted during the last week:\n" +
+		"  ocm audit log --since 7d --method DELETE --path /api/clusters_mgmt/v1/clusters\n\n" +
+		"  # Show all the records of a file collected from another machine as JSON:\n" +
+		"  ocm audit log --file audit.jsonl --output json",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+	fs.StringVar(
+		&args.file,
+		"file",
+		"",
+		"Audit log file to read. The default is the file given in the 'audit_log' setting.",
+	)
+	fs.StringVar(
+		&args.since,
+		"since",
+		"",
+		"Show only the records written after the given time. It can be a duration like '24h' "+
+			"or '7d', a date like '2026-01-31' or a time like '2026-01-31T12:00:00Z'.",
+	)
+	fs.StringVar(
+		&args.user,
+		"user",
+		"",
+		"Show only the records of requests sent by the given user.",
+	)
+	fs.StringVar(
+		&args.method,
+		"method",
+		"",
+		"Show only the records of requests with the given method, for example 'DELETE'.",
+	)
+	fs.StringVar(
+		&args.path,
+		"path",
+		"",
+		"Show only the records of requests whose path starts with the given prefix.",
+	)
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Prepare the query:
+	query := &audit.Query{
+		User:   args.user,
+		Method: args.method,
+		Path:   args.path,
+	}
+	if args.since != "" {
+		since, err := audit.ParseSince(args.since, time.Now())
+		if err != nil {
+			return fmt.Errorf("Invalid '--since' value: %v", err)
+		}
+		query.Since = since
+	}
+
+	// Find the file:
+	file := args.file
+	if file == "" {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("Can't load config file: %v", err)
+		}
+		if cfg == nil || cfg.AuditLog == "" {
+			return fmt.Errorf(
+				"The audit log isn't enabled, run 'ocm config set audit_log FILE' to enable it " +
+					"or use '--file' to read an existing file",
+			)
+		}
+		file = cfg.AuditLog
+	}
+	file, err := audit.ExpandFile(file)
+	if err != nil {
+		return err
+	}
+
+	// Read the records:
+	records, err := audit.Read(file, query)
+	if err != nil {
+		return fmt.Errorf("Can't read audit log: %v", err)
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("audit_log").
+		Columns("time, user, method, path, status, request_id, command").
+		Value("time", func(record *audit.Record) string {
+			return record.Time.Local().Format(time.RFC3339)
+		}).
+		Value("status", func(record *audit.Record) string {
+			if record.Error != "" {
+				return "error: " + record.Error
+			}
+			return fmt.Sprint(record.Status)
+		}).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, record := range records {
+		err = table.WriteObject(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster/plan"
+	c "github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
+)
+
+var args struct {
+	file string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "apply [flags] [CLUSTER]",
+	Short: "Update a cluster to match its specification",
+	Long: "Compare the live cluster with the specification file, like 'ocm cluster plan', and " +
+		"update only the fields that changed. Nothing is changed if any of the changed fields " +
+		"can't be changed after the cluster is created, like the region or the network.\n\n" +
+		"The cluster is identified by the 'name' field of the specification, or by the name, " +
+		"identifier or external identifier given as argument.",
+	Example: "  # Update the cluster to match the file:\n" +
+		"  ocm cluster apply -f cluster.yaml",
+	Args: cobra.MaximumNArgs(1),
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	fs.StringVarP(
+		&args.file,
+		"file",
+		"f",
+		"",
+		"YAML or JSON file that contains the specification of the cluster.",
+	)
+	//nolint:gosec
+	Cmd.MarkFlagRequired("file")
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	key := ""
+	if len(argv) == 1 {
+		key = argv[0]
+	}
+
+	// Create the client for the OCM API:
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		return fmt.Errorf("Failed to create OCM connection: %v", err)
+	}
+	defer connection.Close()
+
+	clusterPlan, err := c.LoadPlan(connection, args.file, key)
+	if err != nil {
+		return err
+	}
+	err = plan.Write(clusterPlan)
+	if err != nil {
+		return err
+	}
+	if len(clusterPlan.Changes) == 0 {
+		return nil
+	}
+	err = clusterPlan.Apply(connection.ClustersMgmt().V1().Clusters())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Cluster '%s' updated\n", clusterPlan.Cluster.Name())
+	return nil
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package cluster
 
 import (
+	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster/apply"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster/login"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster/plan"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster/status"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +32,8 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
+	Cmd.AddCommand(apply.Cmd)
 	Cmd.AddCommand(login.Cmd)
+	Cmd.AddCommand(plan.Cmd)
 	Cmd.AddCommand(status.Cmd)
 }
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	c "github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/clusterspec"
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
+)
+
+var args struct {
+	file string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "plan [flags] [CLUSTER]",
+	Short: "Compare a cluster with its specification",
+	Long: "Compare the live cluster with the specification file and show the fields that " +
+		"'ocm cluster apply' would change. Only the fields present in the file are compared. " +
+		"Fields marked with '!' can't be changed after the cluster is created.\n\n" +
+		"The cluster is identified by the 'name' field of the specification, or by the name, " +
+		"identifier or external identifier given as argument.",
+	Example: "  # Show the changes needed to make the cluster match the file:\n" +
+		"  ocm cluster plan -f cluster.yaml",
+	Args: cobra.MaximumNArgs(1),
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	fs.StringVarP(
+		&args.file,
+		"file",
+		"f",
+		"",
+		"YAML or JSON file that contains the specification of the cluster.",
+	)
+	//nolint:gosec
+	Cmd.MarkFlagRequired("file")
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	key := ""
+	if len(argv) == 1 {
+		key = argv[0]
+	}
+
+	// Create the client for the OCM API:
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		return fmt.Errorf("Failed to create OCM connection: %v", err)
+	}
+	defer connection.Close()
+
+	plan, err := c.LoadPlan(connection, args.file, key)
+	if err != nil {
+		return err
+	}
+	return Write(plan)
+}
+
+// Write writes the changes of the plan and a summary of them.
+func Write(plan *c.Plan) error {
+	cluster := plan.Cluster
+	if len(plan.Changes) == 0 {
+		fmt.Printf("Cluster '%s' (%s) matches the specification\n", cluster.Name(), cluster.ID())
+		return nil
+	}
+	fmt.Printf("Cluster '%s' (%s) differs from the specification:\n", cluster.Name(), cluster.ID())
+	err := clusterspec.WriteChanges(os.Stdout, plan.Changes)
+	if err != nil {
+		return err
+	}
+	immutable := len(clusterspec.Immutable(plan.Changes))
+	fmt.Printf(
+		"\n%d to change, %d can't be changed\n",
+		len(plan.Changes)-immutable, immutable,
+	)
+	return nil
+}
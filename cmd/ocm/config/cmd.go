@@ -18,27 +18,31 @@ package config
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/edit"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/config/get"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/list"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/listprofiles"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/config/set"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/unset"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/useprofile"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/config/view"
 	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/properties"
 )
 
 func configVarDocs() (ret string) {
-	// TODO(efried): Figure out how to get the Type without instantiating.
-	configType := reflect.ValueOf(config.Config{}).Type()
-	fieldHelps := make([]string, configType.NumField())
-	for i := 0; i < len(fieldHelps); i++ {
-		tag := configType.Field(i).Tag
-		// TODO(efried): Use JSON parser instead
-		name := strings.Split(tag.Get("json"), ",")[0]
-		doc := tag.Get("doc")
-		fieldHelps[i] = fmt.Sprintf("\t%-15s%s", name, doc)
+	fields := config.Fields()
+	fieldHelps := make([]string, len(fields))
+	for i, field := range fields {
+		doc := field.Doc()
+		if field.Secret() {
+			doc += " Secret, redacted by 'ocm config list'."
+		}
+		fieldHelps[i] = fmt.Sprintf("\t%-15s%s", field.Name(), doc)
 	}
 	ret = strings.Join(fieldHelps, "\n")
 	return
@@ -59,6 +63,21 @@ The following variables are supported:
 
 %s
 
+Use "ocm config list" to see all the variables that have a value, "ocm config unset" to remove a
+value and "ocm config edit" to change several variables at once using the editor given by the
+'EDITOR' environment variable.
+
+The configuration can contain multiple named profiles, each with its own URLs, credentials and
+tokens. Profiles are created with "ocm login --profile NAME", listed with "ocm config list-profiles"
+and selected with "ocm config use-profile NAME". The '--profile' flag and the '%s' environment
+variable select a profile for a single command. The variables above are read from and written to
+the selected profile.
+
+Every variable can also be overridden with an environment variable with the '%s' prefix and
+the name of the variable in upper case, for example '%sCLIENT_ID'. These values are used without
+being saved. Use "ocm config view --show-origin" to see the effective values and where they come
+from.
+
 Note that "ocm config get access_token" gives whatever the file contains - may be missing or expired;
 you probably want "ocm token" command instead which will obtain a fresh token if needed.
 
@@ -70,7 +89,8 @@ following backends are supported for the keyring:
 - Windows: wincred
 
 Available Keyrings on your OS: %s
-`, loc, configVarDocs(), properties.KeyringEnvKey, strings.Join(config.GetKeyrings(), ", "))
+`, loc, configVarDocs(), properties.ProfileEnvKey, properties.ConfigEnvPrefix, properties.ConfigEnvPrefix,
+		properties.KeyringEnvKey, strings.Join(config.GetKeyrings(), ", "))
 	return
 }
 
@@ -83,4 +103,10 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(unset.Cmd)
+	Cmd.AddCommand(list.Cmd)
+	Cmd.AddCommand(edit.Cmd)
+	Cmd.AddCommand(view.Cmd)
+	Cmd.AddCommand(useprofile.Cmd)
+	Cmd.AddCommand(listprofiles.Cmd)
 }
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edits the configuration with a text editor",
+	Long: "Opens the configuration of the selected profile in the editor given by the 'EDITOR' " +
+		"environment variable, or 'vi' if it isn't set. The configuration is checked when the " +
+		"editor exits, and it is saved only if it is valid. If it isn't valid the edited text is " +
+		"preserved in a temporary file so that it isn't lost.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Load the configuration:
+	cfg, err := config.LoadStored()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	// Write the configuration to a temporary file that is only readable by the user, as it
+	// may contain secrets:
+	original, err := json.MarshalIndent(cfg, "", "  ") // #nosec G117
+	if err != nil {
+		return fmt.Errorf("Can't marshal config: %v", err)
+	}
+	original = append(original, '\n')
+	file, err := os.CreateTemp("", "ocm-config-*.json")
+	if err != nil {
+		return fmt.Errorf("Can't create temporary file: %v", err)
+	}
+	path := file.Name()
+	_, err = file.Write(original)
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		os.Remove(path)
+		return fmt.Errorf("Can't write temporary file '%s': %v", path, err)
+	}
+
+	// Run the editor:
+	err = runEditor(path)
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	// Read the result, and do nothing if it hasn't changed:
+	// #nosec G304
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		os.Remove(path)
+		return fmt.Errorf("Can't read temporary file '%s': %v", path, err)
+	}
+	if bytes.Equal(bytes.TrimSpace(edited), bytes.TrimSpace(original)) {
+		os.Remove(path)
+		fmt.Fprintf(os.Stderr, "Edit cancelled, no changes made.\n")
+		return nil
+	}
+
+	// Check the result, and preserve it if it isn't valid:
+	result, err := parseConfig(edited)
+	if err != nil {
+		return fmt.Errorf(
+			"Edited configuration isn't valid: %v. A copy of the changes has been saved "+
+				"to '%s'",
+			err, path,
+		)
+	}
+	os.Remove(path)
+
+	// Save the configuration:
+	err = config.SaveStored(result)
+	if err != nil {
+		return fmt.Errorf("Can't save config file: %v", err)
+	}
+
+	return nil
+}
+
+// parseConfig parses and validates the configuration written by the user. Unknown settings are
+// rejected, as they are most probably typos.
+func parseConfig(data []byte) (result *config.Config, err error) {
+	result = &config.Config{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(result)
+	if err != nil {
+		return
+	}
+	err = result.Validate()
+	return
+}
+
+// runEditor runs the editor selected by the user to edit the given file, connected to the
+// standard input and output of the process.
+func runEditor(path string) error {
+	// Separate the command name and the arguments, using the default editor if the variable is
+	// empty or contains only blanks:
+	editor := os.Getenv("EDITOR")
+	chunks := strings.Fields(editor)
+	if len(chunks) == 0 {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+		chunks = []string{editor}
+	}
+	editorPath, err := exec.LookPath(chunks[0])
+	if err != nil {
+		return fmt.Errorf("Can't find editor '%s': %v", chunks[0], err)
+	}
+	editorArgs := append(chunks[1:], path)
+
+	// #nosec G204
+	editorCmd := exec.Command(editorPath, editorArgs...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	err = editorCmd.Run()
+	if err != nil {
+		return fmt.Errorf("Editor '%s' failed: %v", editor, err)
+	}
+	return nil
+}
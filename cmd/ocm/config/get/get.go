@@ -30,11 +30,12 @@ var args struct {
 }
 
 var Cmd = &cobra.Command{
-	Use:   "get [flags] VARIABLE",
-	Short: "Prints the value of a config variable",
-	Long:  "Prints the value of a config variable. See 'ocm config --help' for supported config variables.",
-	Args:  cobra.ExactArgs(1),
-	RunE:  run,
+	Use:       "get [flags] VARIABLE",
+	Short:     "Prints the value of a config variable",
+	Long:      "Prints the value of a config variable. See 'ocm config --help' for supported config variables.",
+	Args:      cobra.ExactArgs(1),
+	RunE:      run,
+	ValidArgs: config.FieldNames(),
 }
 
 func init() {
@@ -49,11 +50,17 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 	// Load the configuration file:
-	cfg, err := config.Load()
+	cfg, err := config.LoadStored()
 	if err != nil {
 		return fmt.Errorf("Can't load config file: %v", err)
 	}
 
+	// Find the requested setting:
+	field, err := config.LookupField(argv[0])
+	if err != nil {
+		return err
+	}
+
 	// If the configuration file doesn't exist yet assume that all the configuration settings
 	// are empty:
 	if cfg == nil {
@@ -61,35 +68,9 @@ func run(cmd *cobra.Command, argv []string) error {
 		return nil
 	}
 
-	// Print the value of the requested configuration setting:
-	switch argv[0] {
-	case "access_token":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.AccessToken)
-	case "client_id":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.ClientID)
-	case "client_secret":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.ClientSecret)
-	case "insecure":
-		fmt.Fprintf(os.Stdout, "%v\n", cfg.Insecure)
-	case "opaque_token":
-		fmt.Fprintf(os.Stdout, "%v\n", cfg.OpaqueToken)
-	case "password":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.Password)
-	case "refresh_token":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.RefreshToken)
-	case "scopes":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.Scopes)
-	case "token_url":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.TokenURL)
-	case "url":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.URL)
-	case "pager":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.Pager)
-	case "user":
-		fmt.Fprintf(os.Stdout, "%s\n", cfg.User)
-	default:
-		return fmt.Errorf("Unknown setting")
-	}
+	// Print the value of the requested configuration setting. Note that lists are printed
+	// with the Go syntax, for example `[openid email]`, as scripts may depend on that:
+	fmt.Fprintf(os.Stdout, "%v\n", field.Value(cfg))
 
 	return nil
 }
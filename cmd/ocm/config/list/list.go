@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+)
+
+var args struct {
+	output      string
+	columns     string
+	all         bool
+	showSecrets bool
+}
+
+var Cmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the config variables and their values",
+	Long: "Lists the config variables saved in the selected profile and their values. Use " +
+		"'ocm config view' to see the effective values, including defaults and values from " +
+		"environment variables. The values of " +
+		"secret variables, like tokens and passwords, are replaced with '" + config.Redacted + "' " +
+		"unless the '--show-secrets' flag is used.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+	fs.StringVar(
+		&args.columns,
+		"columns",
+		"name, value",
+		"Specify which columns to display separated by commas. The available columns are "+
+			"'name', 'value', 'type' and 'doc'.",
+	)
+	fs.BoolVar(
+		&args.all,
+		"all",
+		false,
+		"Include the variables that don't have a value.",
+	)
+	fs.BoolVar(
+		&args.showSecrets,
+		"show-secrets",
+		false,
+		"Show the values of secret variables instead of redacting them.",
+	)
+}
+
+// fieldRow is the type of the objects written to the table.
+type fieldRow struct {
+	Name  string
+	Value string
+	Type  string
+	Doc   string
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Load the configuration:
+	cfg, err := config.LoadStored()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("config").
+		Columns(args.columns).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, field := range config.Fields() {
+		if !args.all && !field.IsSet(cfg) {
+			continue
+		}
+		value := field.Redact(cfg)
+		if args.showSecrets {
+			value = field.Get(cfg)
+		}
+		err = table.WriteObject(&fieldRow{
+			Name:  field.Name(),
+			Value: value,
+			Type:  field.Type(),
+			Doc:   field.Doc(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
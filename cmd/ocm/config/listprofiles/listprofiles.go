@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listprofiles
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+)
+
+var args struct {
+	output string
+}
+
+var Cmd = &cobra.Command{
+	Use:     "list-profiles",
+	Aliases: []string{"profiles"},
+	Short:   "Lists the configuration profiles",
+	Long: "Lists the configuration profiles. The profile used by default is marked with an " +
+		"asterisk.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+}
+
+// profileRow is the type of the objects written to the table.
+type profileRow struct {
+	Name   string
+	Active bool
+	Config *config.Config
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Load the configuration:
+	profiles, err := config.LoadProfiles()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if profiles == nil {
+		profiles = &config.Profiles{}
+	}
+	active := profiles.Active()
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("profiles").
+		Columns("active, name, url").
+		Value("active", func(row *profileRow) string {
+			if row.Active {
+				return "*"
+			}
+			return ""
+		}).
+		Value("url", func(row *profileRow) string {
+			return row.Config.URL
+		}).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, name := range profiles.Names() {
+		cfg := profiles.Profiles[name]
+		if cfg == nil {
+			cfg = &config.Config{}
+		}
+		err = table.WriteObject(&profileRow{
+			Name:   name,
+			Active: name == active,
+			Config: cfg,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
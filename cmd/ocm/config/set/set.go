@@ -18,11 +18,11 @@ package set
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/urls"
 )
 
 var args struct {
@@ -32,9 +32,11 @@ var args struct {
 var Cmd = &cobra.Command{
 	Use:   "set [flags] VARIABLE VALUE",
 	Short: "Sets the variable's value",
-	Long:  "Sets the value of a config variable. See 'ocm config --help' for supported config variables.",
-	Args:  cobra.ExactArgs(2),
-	RunE:  run,
+	Long: "Sets the value of a config variable. See 'ocm config --help' for supported config variables. " +
+		"Boolean variables accept 'true' or 'false', and list variables accept comma separated values.",
+	Args:      cobra.ExactArgs(2),
+	RunE:      run,
+	ValidArgs: config.FieldNames(),
 }
 
 func init() {
@@ -49,7 +51,7 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 	// Load the configuration:
-	cfg, err := config.Load()
+	cfg, err := config.LoadStored()
 	if err != nil {
 		return fmt.Errorf("Can't load config file: %v", err)
 	}
@@ -59,46 +61,28 @@ func run(cmd *cobra.Command, argv []string) error {
 		cfg = &config.Config{}
 	}
 
-	// Copy the value given in the command line to the configuration:
-	name := argv[0]
+	// Find the setting:
+	field, err := config.LookupField(argv[0])
+	if err != nil {
+		return err
+	}
+
+	// The URL of the API gateway can also be given as an alias:
 	value := argv[1]
-	switch name {
-	case "access_token":
-		cfg.AccessToken = value
-	case "client_id":
-		cfg.ClientID = value
-	case "client_secret":
-		cfg.ClientSecret = value
-	case "insecure":
-		cfg.Insecure, err = strconv.ParseBool(value)
-		if err != nil {
-			return fmt.Errorf("Failed to set insecure: %v", value)
-		}
-	case "opaque_token":
-		cfg.OpaqueToken, err = strconv.ParseBool(value)
-		if err != nil {
-			return fmt.Errorf("Failed to set opaque_token: %v", value)
+	if field.Name() == "url" {
+		if aliasURL, ok := urls.OCMURLAliases[value]; ok {
+			value = aliasURL
 		}
-	case "password":
-		cfg.Password = value
-	case "refresh_token":
-		cfg.RefreshToken = value
-	case "scopes":
-		return fmt.Errorf("Setting scopes is unsupported")
-	case "token_url":
-		cfg.TokenURL = value
-	case "url":
-		cfg.URL = value
-	case "user":
-		cfg.User = value
-	case "pager":
-		cfg.Pager = value
-	default:
-		return fmt.Errorf("Unknown setting")
+	}
+
+	// Copy the value given in the command line to the configuration:
+	err = field.Set(cfg, value)
+	if err != nil {
+		return err
 	}
 
 	// Save the configuration:
-	err = config.Save(cfg)
+	err = config.SaveStored(cfg)
 	if err != nil {
 		return fmt.Errorf("Can't save config file: %v", err)
 	}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unset
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+)
+
+var Cmd = &cobra.Command{
+	Use:       "unset [flags] VARIABLE",
+	Short:     "Removes the variable's value",
+	Long:      "Removes the value of a config variable. See 'ocm config --help' for supported config variables.",
+	Args:      cobra.ExactArgs(1),
+	RunE:      run,
+	ValidArgs: config.FieldNames(),
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Find the setting:
+	field, err := config.LookupField(argv[0])
+	if err != nil {
+		return err
+	}
+
+	// Load the configuration:
+	cfg, err := config.LoadStored()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+
+	// Nothing to do if the configuration file doesn't exist:
+	if cfg == nil {
+		return nil
+	}
+
+	// Remove the value and save the configuration:
+	field.Unset(cfg)
+	err = config.SaveStored(cfg)
+	if err != nil {
+		return fmt.Errorf("Can't save config file: %v", err)
+	}
+
+	return nil
+}
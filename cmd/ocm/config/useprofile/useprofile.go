@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package useprofile
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "use-profile NAME",
+	Short: "Selects the current configuration profile",
+	Long: "Selects the configuration profile that will be used by default by the rest of the " +
+		"commands. Profiles are created with 'ocm login --profile NAME'.",
+	Args: cobra.ExactArgs(1),
+	RunE: run,
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Load the configuration:
+	profiles, err := config.LoadProfiles()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if profiles == nil {
+		return fmt.Errorf("Profile '%s' doesn't exist, there are no profiles", argv[0])
+	}
+
+	// Change the current profile:
+	err = profiles.Use(argv[0])
+	if err != nil {
+		return err
+	}
+	err = config.SaveProfiles(profiles)
+	if err != nil {
+		return fmt.Errorf("Can't save config file: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+)
+
+var args struct {
+	output      string
+	showOrigin  bool
+	showSecrets bool
+}
+
+var Cmd = &cobra.Command{
+	Use:   "view",
+	Short: "Displays the effective configuration",
+	Long: "Displays the effective configuration of the selected profile. That is the result of " +
+		"combining the default values, the values saved in the configuration file or keyring, " +
+		"the 'OCM_*' environment variables, for example 'OCM_CLIENT_ID', and the command line " +
+		"flags, in that order of priority. The values of secret variables are replaced with '" +
+		config.Redacted + "' unless the '--show-secrets' flag is used.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	fs := Cmd.Flags()
+	output.AddFormatFlag(fs, &args.output)
+	fs.BoolVar(
+		&args.showOrigin,
+		"show-origin",
+		false,
+		"Show where each value comes from: 'default', 'file:PATH', 'keyring:NAME', "+
+			"'env:NAME' or 'flag:NAME'.",
+	)
+	fs.BoolVar(
+		&args.showSecrets,
+		"show-secrets",
+		false,
+		"Show the values of secret variables instead of redacting them.",
+	)
+}
+
+// fieldRow is the type of the objects written to the table.
+type fieldRow struct {
+	Name   string
+	Value  string
+	Origin string
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Load the configuration:
+	cfg, origins, err := config.LoadEffective()
+	if err != nil {
+		return fmt.Errorf("Can't load config: %v", err)
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	columns := "name, value"
+	if args.showOrigin {
+		columns += ", origin"
+	}
+	table, err := printer.NewTable().
+		Name("config").
+		Columns(columns).
+		Format(args.output).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows for the settings that have a value:
+	for _, field := range config.Fields() {
+		origin, ok := origins[field.Name()]
+		if !ok {
+			continue
+		}
+		value := field.Redact(cfg)
+		if args.showSecrets {
+			value = field.Get(cfg)
+		}
+		err = table.WriteObject(&fieldRow{
+			Name:   field.Name(),
+			Value:  value,
+			Origin: origin.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Close()
+}
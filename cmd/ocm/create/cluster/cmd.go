@@ -34,6 +34,7 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/arguments"
 	"github.com/openshift-online/ocm-cli/pkg/billing"
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/clusterspec"
 	ingresspkg "github.com/openshift-online/ocm-cli/pkg/ingress"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/provider"
@@ -76,8 +77,12 @@ var args struct {
 	domainPrefix string
 
 	// flags
-	interactive bool
-	dryRun      bool
+	interactive   bool
+	dryRun        bool
+	fromFile      string
+	exportSpec    string
+	exportCommand bool
+	wait          arguments.WaitArgs
 
 	region                string
 	version               string
@@ -322,7 +327,33 @@ var Cmd = &cobra.Command{
 	Short: "Create managed clusters",
 	Long: fmt.Sprintf("Create managed OpenShift Dedicated v4 clusters via OCM.\n"+
 		"\n"+
-		"NAME %s", clusterNameHelp),
+		"NAME %s\n"+
+		"\n"+
+		"The cluster can also be described in a YAML or JSON file given with '--from-file'. "+
+		"Each field of the file corresponds to a flag, and flags given in the command line "+
+		"override the values of the file. Values can reference environment variables, like "+
+		"'${AWS_SECRET_ACCESS_KEY}', so that secrets don't need to be stored in the file.", clusterNameHelp),
+	Example: "  # Create the cluster described in a file, changing the number of nodes:\n" +
+		"  ocm create cluster --from-file cluster.yaml --compute-nodes 6\n" +
+		"\n" +
+		"  # Answer the questions interactively and save the answers for later:\n" +
+		"  ocm create cluster --interactive --dry-run --export-spec cluster.yaml\n" +
+		"\n" +
+		"  # Example of a file:\n" +
+		"  api_version: v1\n" +
+		"  kind: Cluster\n" +
+		"  name: my-cluster\n" +
+		"  provider: aws\n" +
+		"  region: us-east-1\n" +
+		"  ccs:\n" +
+		"    enabled: true\n" +
+		"    aws:\n" +
+		"      account_id: \"123456789012\"\n" +
+		"      access_key_id: ${AWS_ACCESS_KEY_ID}\n" +
+		"      secret_access_key: ${AWS_SECRET_ACCESS_KEY}\n" +
+		"  compute:\n" +
+		"    machine_type: m5.xlarge\n" +
+		"    nodes: 3",
 	PreRunE: preRun,
 	RunE:    run,
 }
@@ -336,6 +367,30 @@ func init() {
 		false,
 		"Simulate creating the cluster.",
 	)
+	fs.StringVar(
+		&args.fromFile,
+		"from-file",
+		"",
+		"Read the specification of the cluster from the given YAML or JSON file. Flags given "+
+			"in the command line override the values of the file.",
+	)
+	fs.StringVar(
+		&args.exportSpec,
+		"export-spec",
+		"",
+		"Write the specification of the cluster, including the answers given in interactive "+
+			"mode, to the given file, or to the standard output if the file is '-'. Secrets are "+
+			"replaced by references to environment variables.",
+	)
+	fs.BoolVar(
+		&args.exportCommand,
+		"export-command",
+		false,
+		"Write the non-interactive command line that creates the same cluster, including the "+
+			"answers given in interactive mode. Secrets are replaced by references to environment "+
+			"variables.",
+	)
+	arguments.AddWaitFlags(fs, "wait", &args.wait)
 
 	arguments.AddProviderFlag(fs, &args.provider)
 	Cmd.RegisterFlagCompletionFunc("provider", arguments.MakeCompleteFunc(osdProviderOptions))
@@ -927,13 +982,23 @@ func preRun(cmd *cobra.Command, argv []string) error {
 		connection.Close()
 	}()
 
-	err = promptName(argv)
+	// Validate flags / ask for missing data.
+	fs := cmd.Flags()
+
+	err = args.wait.Check(fs)
 	if err != nil {
 		return err
 	}
 
-	// Validate flags / ask for missing data.
-	fs := cmd.Flags()
+	argv, err = applySpecFile(fs, argv)
+	if err != nil {
+		return err
+	}
+
+	err = promptName(argv)
+	if err != nil {
+		return err
+	}
 
 	if cmd.Flags().Changed("root-disk-size") && args.rootDiskSize <= 0 {
 		return fmt.Errorf("--root-disk-size must be a positive value")
@@ -1174,6 +1239,13 @@ func preRun(cmd *cobra.Command, argv []string) error {
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Export the specification before creating the cluster, so that the answers given in
+	// interactive mode aren't lost if that fails:
+	err := exportSpec(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	// TODO: can we reuse the connection from preRun()?
 	// TODO: call config.Save (https://github.com/openshift-online/ocm-cli/issues/153).
 	connection, err := ocm.NewConnection().Build()
@@ -1248,6 +1320,11 @@ func run(cmd *cobra.Command, argv []string) error {
 		if err != nil {
 			return err
 		}
+		err = args.wait.Run(context.Background(), connection.ClustersMgmt().V1().Clusters(),
+			cluster.ID(), c.WatchReady)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1292,6 +1369,77 @@ func wasClusterWideProxyReceived() bool {
 		(args.clusterWideProxy.AdditionalTrustBundleFile != nil && *args.clusterWideProxy.AdditionalTrustBundleFile != "")
 }
 
+// applySpecFile sets the flags that weren't used in the command line to the values of the
+// specification file given with '--from-file', if any, so that they are validated like the flags.
+// The name of the cluster is taken from the file when it isn't given as argument.
+func applySpecFile(fs *pflag.FlagSet, argv []string) ([]string, error) {
+	if args.fromFile == "" {
+		return argv, nil
+	}
+	spec, err := clusterspec.Load(args.fromFile, os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	if len(argv) == 0 && spec.Name != "" {
+		argv = []string{spec.Name}
+	}
+	for _, flag := range spec.Flags() {
+		if fs.Changed(flag.Name) {
+			continue
+		}
+		for _, value := range flag.Values {
+			err = fs.Set(flag.Name, value)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value for '--%s' in '%s': %v", flag.Name, args.fromFile, err)
+			}
+		}
+	}
+	return argv, nil
+}
+
+// exportSpec writes the specification of the cluster to the file given with '--export-spec', and
+// the equivalent command line to the standard output when '--export-command' is used. The values
+// are taken from the flags that were set, either in the command line, from the file given with
+// '--from-file' or by the interactive prompts, and from the flags whose defaults were calculated.
+func exportSpec(fs *pflag.FlagSet) error {
+	if args.exportSpec == "" && !args.exportCommand {
+		return nil
+	}
+	spec, err := clusterspec.FromFlags(func(name string) ([]string, bool) {
+		flag := fs.Lookup(name)
+		if flag == nil || (!flag.Changed && flag.Value.String() == flag.DefValue) {
+			return nil, false
+		}
+		if value, ok := flag.Value.(pflag.SliceValue); ok {
+			return value.GetSlice(), true
+		}
+		return []string{flag.Value.String()}, true
+	})
+	if err != nil {
+		return fmt.Errorf("Can't export cluster specification: %v", err)
+	}
+	spec.Name = args.clusterName
+	spec.Redact()
+	if args.exportSpec != "" {
+		data, err := spec.Marshal()
+		if err != nil {
+			return fmt.Errorf("Can't export cluster specification: %v", err)
+		}
+		if args.exportSpec == "-" {
+			_, err = os.Stdout.Write(data)
+		} else {
+			err = os.WriteFile(args.exportSpec, data, 0600)
+		}
+		if err != nil {
+			return fmt.Errorf("Can't write cluster specification to '%s': %v", args.exportSpec, err)
+		}
+	}
+	if args.exportCommand {
+		fmt.Println(spec.Command())
+	}
+	return nil
+}
+
 // promptName checks and/or reads the cluster name
 func promptName(argv []string) error {
 	if len(argv) == 1 && argv[0] != "" {
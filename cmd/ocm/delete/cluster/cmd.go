@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/arguments"
+	c "github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
+)
+
+var args struct {
+	yes         bool
+	deprovision bool
+	bestEffort  bool
+	watch       arguments.WaitArgs
+}
+
+var Cmd = &cobra.Command{
+	Use:   "cluster [flags] {NAME|ID|EXTERNAL_ID}",
+	Short: "Delete a cluster",
+	Long: "Delete a cluster and uninstall it. A summary of the cluster is displayed and the name " +
+		"of the cluster has to be typed to confirm, unless '--yes' is used. Clusters with " +
+		"delete protection enabled can't be deleted.",
+	Example: `  # Delete a cluster named "mycluster", asking for confirmation
+  ocm delete cluster mycluster
+
+  # Delete a cluster without confirmation and wait till it is uninstalled
+  ocm delete cluster mycluster --yes --watch`,
+	Args: cobra.ExactArgs(1),
+	RunE: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+	flags.BoolVarP(
+		&args.yes,
+		"yes",
+		"y",
+		false,
+		"Skip the interactive confirmation prompt.",
+	)
+	flags.BoolVar(
+		&args.deprovision,
+		"deprovision",
+		true,
+		"Deprovision the cloud resources of the cluster. Set to false to only remove the "+
+			"cluster from OCM, leaving the resources in the cloud account.",
+	)
+	flags.BoolVar(
+		&args.bestEffort,
+		"best-effort",
+		false,
+		"Continue deleting the cluster even if some of the cloud resources can't be "+
+			"deprovisioned. The remaining resources have to be deleted manually.",
+	)
+	arguments.AddWaitFlags(flags, "watch", &args.watch)
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Check that the cluster key (name, identifier or external identifier) given by the user
+	// is reasonably safe so that there is no risk of SQL injection:
+	clusterKey := argv[0]
+	if !c.IsValidClusterKey(clusterKey) {
+		return fmt.Errorf(
+			"Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores",
+			clusterKey,
+		)
+	}
+
+	err := args.watch.Check(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	// Create the client for the OCM API:
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		return fmt.Errorf("Failed to create OCM connection: %v", err)
+	}
+	defer connection.Close()
+
+	// Get the client for the cluster management api
+	clusterCollection := connection.ClustersMgmt().V1().Clusters()
+
+	cluster, err := c.GetCluster(connection, clusterKey)
+	if err != nil {
+		return fmt.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+	}
+
+	// Show what will be deleted:
+	fmt.Printf(""+
+		"ID:		%s\n"+
+		"External ID:	%s\n"+
+		"Name:		%s\n"+
+		"Provider:	%s\n"+
+		"Region:		%s\n"+
+		"Version:	%s\n"+
+		"State:		%s\n"+
+		"Created:	%s\n",
+		cluster.ID(),
+		cluster.ExternalID(),
+		cluster.Name(),
+		cluster.CloudProvider().ID(),
+		cluster.Region().ID(),
+		cluster.OpenshiftVersion(),
+		cluster.State(),
+		cluster.CreationTimestamp().Round(time.Second).Format(time.RFC3339),
+	)
+
+	if cluster.DeleteProtection().Enabled() {
+		return fmt.Errorf(
+			"Cluster '%s' has delete protection enabled, disable it with "+
+				"'ocm edit cluster %s --enable-delete-protection=false' before deleting it",
+			cluster.Name(), cluster.ID(),
+		)
+	}
+
+	if !args.yes {
+		confirmed, err := confirmName(cluster.Name())
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return fmt.Errorf("The name doesn't match, cluster '%s' wasn't deleted", cluster.Name())
+		}
+	}
+
+	request := clusterCollection.Cluster(cluster.ID()).Delete()
+	if cmd.Flags().Changed("deprovision") {
+		request.Deprovision(args.deprovision)
+	}
+	if cmd.Flags().Changed("best-effort") {
+		request.BestEffort(args.bestEffort)
+	}
+	_, err = request.Send()
+	if err != nil {
+		return fmt.Errorf("Failed to delete cluster '%s': %v", clusterKey, err)
+	}
+	fmt.Printf("Cluster '%s' will start uninstalling now\n", cluster.Name())
+
+	return args.watch.Run(context.Background(), clusterCollection, cluster.ID(), c.WatchDeleted)
+}
+
+// confirmName asks the user to type the name of the cluster, and checks that it matches.
+func confirmName(name string) (bool, error) {
+	fmt.Printf("\nThis will delete the cluster and can't be undone. To confirm, type its name: ")
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil && input == "" {
+		return false, fmt.Errorf("Failed to read confirmation input: %v", err)
+	}
+	fmt.Println()
+	return strings.TrimSpace(input) == name, nil
+}
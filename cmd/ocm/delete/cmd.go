@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift-online/ocm-cli/cmd/ocm/delete/cluster"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/delete/idp"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/delete/ingress"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/delete/machinepool"
@@ -29,6 +30,7 @@ import (
 	"github.com/openshift-online/ocm-cli/cmd/ocm/delete/user"
 	"github.com/openshift-online/ocm-cli/pkg/arguments"
 	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/dryrun"
 	"github.com/openshift-online/ocm-cli/pkg/dump"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
@@ -38,14 +40,18 @@ import (
 var args struct {
 	parameter []string
 	header    []string
+	jq        string
+	raw       bool
+	dryRun    bool
+	printCurl bool
 }
 
 var Cmd = &cobra.Command{
-	Use:       "delete [flags] (PATH | RESOURCE_ALIAS RESOURCE_ID)",
-	Short:     "Send a DELETE request",
-	Long:      "Send a DELETE request to the given path.",
-	RunE:      run,
-	ValidArgs: urls.Resources(),
+	Use:               "delete [flags] (PATH | RESOURCE_ALIAS RESOURCE_ID)",
+	Short:             "Send a DELETE request",
+	Long:              "Send a DELETE request to the given path.",
+	RunE:              run,
+	ValidArgsFunction: arguments.CompletePath,
 }
 
 // for template format refer: https://pkg.go.dev/text/template
@@ -83,10 +89,13 @@ var helpTemplate = `{{with (or .Long .Short)}}{{. | trimTrailingWhitespaces}}
 func init() {
 	Cmd.SetUsageTemplate(usageTemplate)
 	Cmd.SetHelpTemplate(helpTemplate)
-	Cmd.Example = "  account\n  addon\n  cluster\n  role_binding\n  sku_rule\n  subscription"
+	Cmd.Example = "  account\n  addon\n  role_binding\n  sku_rule\n  subscription"
 	fs := Cmd.Flags()
 	arguments.AddParameterFlag(fs, &args.parameter)
 	arguments.AddHeaderFlag(fs, &args.header)
+	dump.AddQueryFlags(fs, &args.jq, &args.raw)
+	dryrun.AddFlags(fs, &args.dryRun, &args.printCurl)
+	Cmd.AddCommand(cluster.Cmd)
 	Cmd.AddCommand(idp.Cmd)
 	Cmd.AddCommand(ingress.Cmd)
 	Cmd.AddCommand(machinepool.Cmd)
@@ -100,6 +109,17 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("could not create URI: %w", err)
 	}
 
+	// Parse the jq expression given by the user, if any:
+	var query *dump.Query
+	if args.jq != "" {
+		query, err = dump.ParseQuery(args.jq, args.raw)
+		if err != nil {
+			return err
+		}
+	} else if args.raw {
+		return fmt.Errorf("Option '--raw-output' can only be used together with '--jq'")
+	}
+
 	// Load the configuration file:
 	cfg, err := config.Load()
 	if err != nil {
@@ -109,8 +129,13 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("not logged in, run the 'login' command")
 	}
 
-	// Create the client for the OCM API:
-	connection, err := ocm.NewConnection().Build()
+	// Create the client for the OCM API. In dry run mode the requests are described instead
+	// of being sent:
+	builder := ocm.NewConnection()
+	if args.dryRun || args.printCurl {
+		builder.TransportWrapper(dryrun.Wrapper(os.Stdout, args.printCurl, cfg.TokenURL))
+	}
+	connection, err := builder.Build()
 	if err != nil {
 		return fmt.Errorf("failed to create OCM connection: %w", err)
 	}
@@ -133,7 +158,9 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 	status := response.Status()
 	body := response.Bytes()
-	if status < 400 {
+	if status < 400 && query != nil {
+		err = query.Pretty(os.Stdout, body)
+	} else if status < 400 {
 		err = dump.Pretty(os.Stdout, body)
 	} else {
 		err = dump.Pretty(os.Stderr, body)
@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 
@@ -27,11 +28,13 @@ import (
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
 	"github.com/openshift-online/ocm-cli/pkg/dump"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/output"
 )
 
 var args struct {
 	json   bool
 	output bool
+	format string
 }
 
 var Cmd = &cobra.Command{
@@ -56,9 +59,48 @@ func init() {
 		false,
 		"Output the entire JSON structure",
 	)
+	flags.StringVarP(
+		&args.format,
+		"format",
+		"o",
+		"",
+		"Output format. One of: json, go-template=..., go-template-file=..., jsonpath=... "+
+			"or jsonpath-file=.... By default a human readable description is written.",
+	)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Check the output format, before contacting the server:
+	format, err := output.ParseFormat(args.format)
+	if err != nil {
+		return err
+	}
+	if args.format != "" && format != output.FormatJSON && !format.Template() {
+		return fmt.Errorf("Output format '%s' isn't supported by this command", format)
+	}
+	if format == output.FormatJSON {
+		args.json = true
+	}
+
+	// Parse the template given by the user, if any:
+	var template *output.Template
+	if format.Template() {
+		printer, err := output.NewPrinter().
+			Writer(os.Stdout).
+			Build(ctx)
+		if err != nil {
+			return err
+		}
+		defer printer.Close()
+		template, err = printer.NewTemplate(args.format)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Check that there is exactly one cluster name, identifir or external identifier in the
 	// command line arguments:
 	if len(argv) != 1 {
@@ -112,6 +154,11 @@ func run(cmd *cobra.Command, argv []string) error {
 		}
 	}
 
+	// Write the cluster using the template given by the user:
+	if template != nil {
+		return template.Execute(cluster)
+	}
+
 	// Get full API response (JSON):
 	if args.json {
 		// Buffer for pretty output:
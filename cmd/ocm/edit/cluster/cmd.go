@@ -14,6 +14,7 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift-online/ocm-cli/pkg/arguments"
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/utils"
@@ -40,6 +42,8 @@ var args struct {
 	channelGroup string
 
 	clusterWideProxy c.ClusterWideProxy
+
+	wait arguments.WaitArgs
 }
 
 var Cmd = &cobra.Command{
@@ -134,6 +138,8 @@ func init() {
 		false,
 		"Enable cluster delete protection against accidental cluster deletion.",
 	)
+
+	arguments.AddWaitFlags(flags, "wait", &args.wait)
 }
 
 func isGCPNetworkEmpty(network *cmv1.GCPNetwork) bool {
@@ -167,6 +173,11 @@ func run(cmd *cobra.Command, argv []string) error {
 		)
 	}
 
+	err := args.wait.Check(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -305,6 +316,5 @@ func run(cmd *cobra.Command, argv []string) error {
 		}
 	}
 
-	return nil
-
+	return args.wait.Run(context.Background(), clusterCollection, cluster.ID(), c.WatchReady)
 }
@@ -82,7 +82,6 @@ func listDnsZoneCmd(cmd *cobra.Command, argv []string) error {
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Unless noHeaders set, print header row:
 	if !ListDnsZoneOpts.noHeaders {
@@ -120,5 +119,5 @@ func listDnsZoneCmd(cmd *cobra.Command, argv []string) error {
 		}
 		index++
 	}
-	return nil
+	return table.Close()
 }
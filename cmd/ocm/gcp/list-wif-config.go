@@ -92,7 +92,6 @@ func listWorkloadIdentityConfigurationCmd(cmd *cobra.Command, argv []string) err
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Unless noHeaders set, print header row:
 	if !ListWorkloadIdentityConfigurationOpts.noHeaders {
@@ -129,5 +128,5 @@ func listWorkloadIdentityConfigurationCmd(cmd *cobra.Command, argv []string) err
 		}
 		index++
 	}
-	return nil
+	return table.Close()
 }
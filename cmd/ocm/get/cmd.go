@@ -17,9 +17,13 @@ limitations under the License.
 package get
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 
+	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift-online/ocm-cli/pkg/arguments"
@@ -27,6 +31,8 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/dump"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/paging"
 	"github.com/openshift-online/ocm-cli/pkg/urls"
 )
 
@@ -34,14 +40,27 @@ var args struct {
 	parameter []string
 	header    []string
 	single    bool
+	output    string
+	all       bool
+	stream    bool
+	pageSize  int
+	maxItems  int
+	jq        string
+	raw       bool
 }
 
 var Cmd = &cobra.Command{
-	Use:       "get RESOURCE [ID]",
-	Short:     "Send a GET request",
-	Long:      "Send a GET request to the given path.",
-	RunE:      run,
-	ValidArgs: urls.Resources(),
+	Use:   "get RESOURCE [ID]",
+	Short: "Send a GET request",
+	Long: "Send a GET request to the given path.\n\n" +
+		"Instead of the path a resource alias can be used, followed by its arguments, for " +
+		"example 'ocm get cluster 123'. Use 'ocm alias list' to see the available aliases " +
+		"and 'ocm alias set' to define new ones.\n\n" +
+		"Collections are returned one page at a time. Use '--all' to fetch all the pages and " +
+		"write a single object that contains the items of all of them, or '--all --stream' to " +
+		"write each item as a separate line as soon as its page is fetched.",
+	RunE:              run,
+	ValidArgsFunction: arguments.CompletePath,
 }
 
 func init() {
@@ -54,13 +73,93 @@ func init() {
 		false,
 		"Return the output as a single line.",
 	)
+	fs.StringVarP(
+		&args.output,
+		"output",
+		"o",
+		"",
+		"Output format. One of: json, go-template=..., go-template-file=..., jsonpath=... "+
+			"or jsonpath-file=.... Templates are applied to the response body.",
+	)
+	fs.BoolVar(
+		&args.all,
+		"all",
+		false,
+		"Fetch all the pages of the collection and write the merged list of items.",
+	)
+	fs.BoolVar(
+		&args.stream,
+		"stream",
+		false,
+		"Used together with '--all', write each item in a separate line, as newline "+
+			"delimited JSON, instead of a merged list. Templates are applied to each item.",
+	)
+	paging.AddFlags(fs, &args.pageSize, &args.maxItems)
+	dump.AddQueryFlags(fs, &args.jq, &args.raw)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Check the output format:
+	format, err := output.ParseFormat(args.output)
+	if err != nil {
+		return err
+	}
+	if args.output != "" && format != output.FormatJSON && !format.Template() {
+		return fmt.Errorf("Output format '%s' isn't supported by this command", format)
+	}
+
+	// Parse the jq expression given by the user, if any:
+	var query *dump.Query
+	if args.jq != "" {
+		if args.output != "" {
+			return fmt.Errorf("Options '--jq' and '--output' can't be used together")
+		}
+		query, err = dump.ParseQuery(args.jq, args.raw)
+		if err != nil {
+			return err
+		}
+	} else if args.raw {
+		return fmt.Errorf("Option '--raw-output' can only be used together with '--jq'")
+	}
+
+	// Check the pagination flags:
+	if !args.all {
+		for _, flag := range []string{"stream", "page-size", "max-items"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("Option '--%s' can only be used together with '--all'", flag)
+			}
+		}
+	}
+
+	// Create the output printer and parse the template given by the user, if any:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+	var template *output.Template
+	if format.Template() {
+		template, err = printer.NewTemplate(args.output)
+		if err != nil {
+			return err
+		}
+	}
+
 	path, err := urls.Expand(argv)
 	if err != nil {
 		return fmt.Errorf("Could not create URI: %v", err)
 	}
+	if args.all {
+		err = checkPagingParameters(path)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Load the configuration file:
 	cfg, err := config.Load()
@@ -78,38 +177,22 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 	defer connection.Close()
 
-	// Create and populate the request:
-	request := connection.Get()
-	err = arguments.ApplyPathArg(request, path)
+	// Check that the path can be parsed before sending any request:
+	_, err = makeRequest(connection, path)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Can't parse path '%s': %v\n", path, err)
 		os.Exit(1)
 	}
-	arguments.ApplyParameterFlag(request, args.parameter)
-	arguments.ApplyHeaderFlag(request, args.header)
 
-	// Send the request:
-	response, err := request.Send()
-	if err != nil {
-		return fmt.Errorf("Can't send request: %v", err)
-	}
-	status := response.Status()
-	body := response.Bytes()
-	if status < 400 {
-		if args.single {
-			err = dump.Single(os.Stdout, body)
-		} else {
-			err = dump.Pretty(os.Stdout, body)
-		}
+	// Send the request, or the requests for all the pages:
+	var status int
+	if args.all {
+		status, err = sendAll(connection, path, template, query)
 	} else {
-		if args.single {
-			err = dump.Single(os.Stderr, body)
-		} else {
-			err = dump.Pretty(os.Stderr, body)
-		}
+		status, err = sendOne(connection, path, template, query)
 	}
 	if err != nil {
-		return fmt.Errorf("Can't print body: %v", err)
+		return err
 	}
 
 	// Save the configuration (skip for opaque tokens since the SDK does not manage them):
@@ -131,3 +214,175 @@ func run(cmd *cobra.Command, argv []string) error {
 
 	return nil
 }
+
+// makeRequest creates and populates a request for the given path. A new request is needed for each
+// page because query parameters can't be replaced once they have been added.
+func makeRequest(connection *sdk.Connection, path string) (request *sdk.Request, err error) {
+	request = connection.Get()
+	err = arguments.ApplyPathArg(request, path)
+	if err != nil {
+		return
+	}
+	arguments.ApplyParameterFlag(request, args.parameter)
+	arguments.ApplyHeaderFlag(request, args.header)
+	return
+}
+
+// checkPagingParameters checks that the 'page' and 'size' query parameters aren't explicitly used,
+// as they are calculated by the '--all' option.
+func checkPagingParameters(path string) error {
+	names := map[string]bool{}
+	for _, parameter := range args.parameter {
+		name, _ := arguments.ParseNameValuePair(parameter)
+		names[name] = true
+	}
+	parsed, err := url.Parse(path)
+	if err == nil {
+		for name := range parsed.Query() {
+			names[name] = true
+		}
+	}
+	if names["page"] || names["size"] {
+		return fmt.Errorf(
+			"The 'page' and 'size' parameters can't be used together with '--all', " +
+				"use '--page-size' and '--max-items' instead",
+		)
+	}
+	return nil
+}
+
+// sendOne sends a single request and writes the response body.
+func sendOne(connection *sdk.Connection, path string, template *output.Template,
+	query *dump.Query) (status int, err error) {
+	request, err := makeRequest(connection, path)
+	if err != nil {
+		return
+	}
+	response, err := request.Send()
+	if err != nil {
+		err = fmt.Errorf("Can't send request: %v", err)
+		return
+	}
+	status = response.Status()
+	err = writeBody(status, response.Bytes(), template, query)
+	return
+}
+
+// sendAll sends the requests for all the pages of a collection, and writes the items of all of
+// them, either merged in a single list or one per line.
+func sendAll(connection *sdk.Connection, path string, template *output.Template,
+	query *dump.Query) (status int, err error) {
+	merged := &paging.Page{
+		Items: []json.RawMessage{},
+	}
+	loop := paging.NewLoop(args.pageSize, args.maxItems)
+	err = loop.Run(func(index, size int) (count, total int, err error) {
+		// Fetch the page:
+		request, err := makeRequest(connection, path)
+		if err != nil {
+			return
+		}
+		request.Parameter("page", index)
+		request.Parameter("size", size)
+		response, err := request.Send()
+		if err != nil {
+			err = fmt.Errorf("Can't send request: %v", err)
+			return
+		}
+
+		// Errors stop the loop, as the returned count is zero:
+		status = response.Status()
+		if status >= 400 {
+			err = writeBody(status, response.Bytes(), nil, nil)
+			return
+		}
+		page, err := paging.ParsePage(response.Bytes())
+		if err != nil {
+			err = fmt.Errorf("Can't use '--all' with '%s': %v", path, err)
+			return
+		}
+
+		// Write or save the items:
+		if merged.Kind == "" {
+			merged.Kind = page.Kind
+		}
+		for _, item := range page.Items {
+			if !loop.Accept() {
+				break
+			}
+			if args.stream {
+				err = writeItem(item, template, query)
+				if err != nil {
+					return
+				}
+			} else {
+				merged.Items = append(merged.Items, item)
+			}
+		}
+		count = len(page.Items)
+		total = page.Total
+		merged.Total = total
+		return
+	})
+	if err != nil || status >= 400 || args.stream {
+		return
+	}
+
+	// Write the merged list:
+	merged.Page = 1
+	merged.Size = len(merged.Items)
+	if merged.Total < merged.Size {
+		merged.Total = merged.Size
+	}
+	body, err := json.Marshal(merged)
+	if err != nil {
+		err = fmt.Errorf("Can't marshal items: %v", err)
+		return
+	}
+	err = writeBody(status, body, template, query)
+	return
+}
+
+// writeBody writes a response body, to the standard output if the request succeeded, or to the
+// standard error if it failed. The template or the jq query are only applied when it succeeded.
+func writeBody(status int, body []byte, template *output.Template, query *dump.Query) (err error) {
+	if status < 400 {
+		if template != nil {
+			err = template.ExecuteJSON(body)
+		} else if query != nil && args.single {
+			err = query.Single(os.Stdout, body)
+		} else if query != nil {
+			err = query.Pretty(os.Stdout, body)
+		} else if args.single {
+			err = dump.Single(os.Stdout, body)
+		} else {
+			err = dump.Pretty(os.Stdout, body)
+		}
+	} else {
+		if args.single {
+			err = dump.Single(os.Stderr, body)
+		} else {
+			err = dump.Pretty(os.Stderr, body)
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("Can't print body: %v", err)
+	}
+	return
+}
+
+// writeItem writes one of the items of a collection in a single line, or using the template or
+// jq query given by the user.
+func writeItem(item []byte, template *output.Template, query *dump.Query) (err error) {
+	if template != nil {
+		err = template.ExecuteJSON(item)
+	} else if query != nil {
+		err = query.Single(os.Stdout, item)
+	} else {
+		err = dump.Single(os.Stdout, item)
+	}
+	if err != nil {
+		err = fmt.Errorf("Can't print item: %v", err)
+	}
+	return
+}
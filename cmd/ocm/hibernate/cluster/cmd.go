@@ -1,15 +1,21 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"os"
 
+	"github.com/openshift-online/ocm-cli/pkg/arguments"
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 
 	"github.com/spf13/cobra"
 )
 
+var args struct {
+	wait arguments.WaitArgs
+}
+
 var Cmd = &cobra.Command{
 	Use:   "cluster {NAME|ID|EXTERNAL_ID}",
 	Short: "Initiate cluster hibernation",
@@ -18,6 +24,10 @@ var Cmd = &cobra.Command{
 	RunE: run,
 }
 
+func init() {
+	arguments.AddWaitFlags(Cmd.Flags(), "wait", &args.wait)
+}
+
 func run(cmd *cobra.Command, argv []string) error {
 	// Check that there is exactly one cluster name, identifir or external identifier in the
 	// command line arguments:
@@ -41,6 +51,11 @@ func run(cmd *cobra.Command, argv []string) error {
 		)
 	}
 
+	err := args.wait.Check(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -61,5 +76,5 @@ func run(cmd *cobra.Command, argv []string) error {
 	if err != nil {
 		return err
 	}
-	return nil
+	return args.wait.Run(context.Background(), clusterCollection, cluster.ID(), c.WatchHibernating)
 }
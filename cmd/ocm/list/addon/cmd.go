@@ -29,6 +29,9 @@ import (
 var args struct {
 	clusterKey string
 	columns    string
+	output     string
+	sortBy     string
+	reverse    bool
 }
 
 var Cmd = &cobra.Command{
@@ -55,8 +58,11 @@ func init() {
 		&args.columns,
 		"columns",
 		"id, name, state",
-		"Comma separated list of columns to display.",
+		"Comma separated list of columns to display. Use 'preset:NAME' to select a preset "+
+			"from the table files in the '~/.config/ocm/tables' directory.",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 
 	//nolint:gosec
 	Cmd.MarkFlagRequired("cluster")
@@ -93,11 +99,13 @@ func run(cmd *cobra.Command, argv []string) error {
 	table, err := printer.NewTable().
 		Name("addons").
 		Columns(args.columns).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Build(ctx)
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Check that the cluster key (name, identifier or external identifier) given by the user
 	// is reasonably safe so that there is no risk of SQL injection:
@@ -124,7 +132,13 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("Failed to get add-ons for cluster '%s': %v", clusterKey, err)
 	}
 
-	if len(clusterAddOns) == 0 {
+	// The message about the missing add-ons would break the format of JSON, YAML and CSV
+	// documents, so in those cases we just write an empty document:
+	format, err := output.ParseFormat(args.output)
+	if err != nil {
+		return err
+	}
+	if len(clusterAddOns) == 0 && format.Text() {
 		fmt.Printf("There are no add-ons installed on cluster '%s'", clusterKey)
 		return nil
 	}
@@ -146,5 +160,5 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
-	return nil
+	return table.Close()
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/paging"
 )
 
 var args struct {
@@ -38,6 +39,11 @@ var args struct {
 	noHeaders bool
 	columns   string
 	padding   int
+	output    string
+	sortBy    string
+	reverse   bool
+	pageSize  int
+	maxItems  int
 }
 
 // Cmd Constant:
@@ -77,7 +83,9 @@ func init() {
 		&args.columns,
 		"columns",
 		"id, name, api.url, openshift_version, product.id, hypershift.enabled, cloud_provider.id, region.id, state",
-		"Specify which columns to display separated by commas, path is based on Cluster struct",
+		"Specify which columns to display separated by commas, path is based on Cluster struct. "+
+			"Use 'preset:NAME' to select a preset from the table files in the '~/.config/ocm/tables' "+
+			"directory.",
 	)
 	fs.IntVar(
 		&args.padding,
@@ -85,6 +93,9 @@ func init() {
 		-1,
 		"Change all column sizes.",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
+	paging.AddFlags(fs, &args.pageSize, &args.maxItems)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
@@ -118,11 +129,13 @@ func run(cmd *cobra.Command, argv []string) error {
 	table, err := printer.NewTable().
 		Name("clusters").
 		Columns(args.columns).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Build(ctx)
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// This will contain the terms used to construct the search query:
 	var searchTerms []string
@@ -184,34 +197,34 @@ func run(cmd *cobra.Command, argv []string) error {
 	arguments.ApplyParameterFlag(request, args.parameter)
 	arguments.ApplyHeaderFlag(request, args.header)
 
-	// Send the request till we receive a page with less items than requested:
-	size := 100
-	index := 1
-	for {
+	// Send the request till we receive a page with less items than requested, or till we
+	// reach the maximum number of items:
+	loop := paging.NewLoop(args.pageSize, args.maxItems)
+	err = loop.Run(func(index, size int) (count, total int, err error) {
 		// Fetch the next page:
 		request.Size(size)
 		request.Page(index)
 		response, err := request.Send()
 		if err != nil {
-			return fmt.Errorf("Can't retrieve clusters: %v", err)
+			err = fmt.Errorf("Can't retrieve clusters: %v", err)
+			return
 		}
 
 		// Display the items of the fetched page:
 		response.Items().Each(func(cluster *v1.Cluster) bool {
+			if !loop.Accept() {
+				return false
+			}
 			err = table.WriteObject(cluster)
 			return err == nil
 		})
-		if err != nil {
-			break
-		}
-
-		// If the number of fetched items is less than requested, then this was the last
-		// page, otherwise process the next one:
-		if response.Size() < size {
-			break
-		}
-		index++
+		count = response.Size()
+		total = response.Total()
+		return
+	})
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return table.Close()
 }
@@ -30,6 +30,9 @@ import (
 var args struct {
 	clusterKey string
 	columns    string
+	output     string
+	sortBy     string
+	reverse    bool
 }
 
 var Cmd = &cobra.Command{
@@ -56,8 +59,11 @@ func init() {
 		&args.columns,
 		"columns",
 		"name, type, auth_url",
-		"Comma separated list of columns to display.",
+		"Comma separated list of columns to display. Use 'preset:NAME' to select a preset "+
+			"from the table files in the '~/.config/ocm/tables' directory.",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 
 	//nolint:gosec
 	Cmd.MarkFlagRequired("cluster")
@@ -122,6 +128,9 @@ func run(cmd *cobra.Command, argv []string) error {
 	table, err := printer.NewTable().
 		Name("idps").
 		Columns(args.columns).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Value("type", getType).
 		Value("auth_url", func(idp *cmv1.IdentityProvider) string {
 			return getAuthURL(cluster, idp.Name())
@@ -130,7 +139,6 @@ func run(cmd *cobra.Command, argv []string) error {
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Write the column headers:
 	err = table.WriteHeaders()
@@ -149,7 +157,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
-	return nil
+	return table.Close()
 }
 
 func getType(idp *cmv1.IdentityProvider) string {
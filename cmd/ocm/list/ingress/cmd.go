@@ -33,6 +33,9 @@ import (
 
 var args struct {
 	clusterKey string
+	output     string
+	sortBy     string
+	reverse    bool
 }
 
 var Cmd = &cobra.Command{
@@ -56,6 +59,8 @@ func init() {
 		"",
 		"Name or ID or external_id of the cluster to list the routes of (required).",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 
 	//nolint:gosec
 	Cmd.MarkFlagRequired("cluster")
@@ -71,6 +76,12 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
+	// Check the output format:
+	format, err := output.ParseFormat(args.output)
+	if err != nil {
+		return err
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -116,33 +127,22 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
 	}
 
-	// Write the endpoints:
-	endpointsTable, err := printer.NewTable().
-		Name("endpoints").
-		Columns("id", "api.url", "api.listening").
-		Value("id", "api").
-		Build(ctx)
-	if err != nil {
-		return err
-	}
-	err = endpointsTable.WriteHeaders()
-	if err != nil {
-		return err
-	}
-	err = endpointsTable.WriteObject(cluster)
-	if err != nil {
-		return err
-	}
-	err = endpointsTable.Close()
-	if err != nil {
-		return err
+	// Write the endpoints. Note that this is only done for text output, because the other
+	// formats can't contain two tables with different columns in the same document.
+	if format.Text() {
+		err = writeEndpoints(ctx, printer, cluster, format)
+		if err != nil {
+			return err
+		}
 	}
-	fmt.Fprintf(printer, "\n")
 
 	// Write the ingresses:
 	ingressesTable, err := printer.NewTable().
 		Name("ingresses").
 		Columns("id", "application_router", "listening", "default", "route_selectors").
+		Format(string(format)).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Value("application_router", applicationRouter).
 		Value("route_selectors", routeSelectors).
 		Build(ctx)
@@ -170,6 +170,33 @@ func run(cmd *cobra.Command, argv []string) error {
 	return nil
 }
 
+func writeEndpoints(ctx context.Context, printer *output.Printer, cluster *cmv1.Cluster,
+	format output.Format) error {
+	endpointsTable, err := printer.NewTable().
+		Name("endpoints").
+		Columns("id", "api.url", "api.listening").
+		Value("id", "api").
+		Format(string(format)).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	err = endpointsTable.WriteHeaders()
+	if err != nil {
+		return err
+	}
+	err = endpointsTable.WriteObject(cluster)
+	if err != nil {
+		return err
+	}
+	err = endpointsTable.Close()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(printer, "\n")
+	return err
+}
+
 func routeSelectors(ingress *cmv1.Ingress) string {
 	routeSelectors := ingress.RouteSelectors()
 	if len(routeSelectors) == 0 {
@@ -17,13 +17,15 @@ limitations under the License.
 package machinepool
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"text/tabwriter"
 
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/output"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/spf13/cobra"
@@ -31,6 +33,10 @@ import (
 
 var args struct {
 	clusterKey string
+	columns    string
+	output     string
+	sortBy     string
+	reverse    bool
 }
 
 var Cmd = &cobra.Command{
@@ -54,11 +60,22 @@ func init() {
 		"",
 		"Name or ID or external_id of the cluster to list the machine pools of (required).",
 	)
+	flags.StringVar(
+		&args.columns,
+		"columns",
+		"id, autoscaling, replicas, instance_type, labels, taints, availability_zones, sg_ids",
+		"Comma separated list of columns to display. Use 'preset:NAME' to select a preset "+
+			"from the table files in the '~/.config/ocm/tables' directory.",
+	)
+	output.AddFormatFlag(flags, &args.output)
+	output.AddSortFlags(flags, &args.sortBy, &args.reverse)
 	//nolint:gosec
 	Cmd.MarkFlagRequired("cluster")
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
 
 	// Check that the cluster key (name, identifier or external identifier) given by the user
 	// is reasonably safe so that there is no risk of SQL injection:
@@ -71,6 +88,12 @@ func run(cmd *cobra.Command, argv []string) error {
 		)
 	}
 
+	// Load the configuration:
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -95,26 +118,61 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Pager(cfg.Pager).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("machinepools").
+		Columns(args.columns).
+		Value("autoscaling", func(machinePool *cmv1.MachinePool) string {
+			return printAutoscaling(machinePool.Autoscaling())
+		}).
+		Value("replicas", func(machinePool *cmv1.MachinePool) string {
+			return printReplicas(machinePool.Autoscaling(), machinePool.Replicas())
+		}).
+		Value("labels", func(machinePool *cmv1.MachinePool) string {
+			return printLabels(machinePool.Labels())
+		}).
+		Value("taints", func(machinePool *cmv1.MachinePool) string {
+			return printTaints(machinePool.Taints())
+		}).
+		Value("availability_zones", func(machinePool *cmv1.MachinePool) string {
+			return printAZ(machinePool.AvailabilityZones())
+		}).
+		Value("sg_ids", func(machinePool *cmv1.MachinePool) string {
+			return printAdditionalSecurityGroups(machinePool.AWS().AdditionalSecurityGroupIds())
+		}).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
 
-	fmt.Fprintf(writer, "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONES\tSG IDs\n")
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
 
+	// Write the rows:
 	for _, machinePool := range machinePools {
-		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t\t%s\t\t%s\t%s\n",
-			machinePool.ID(),
-			printAutoscaling(machinePool.Autoscaling()),
-			printReplicas(machinePool.Autoscaling(), machinePool.Replicas()),
-			machinePool.InstanceType(),
-			printLabels(machinePool.Labels()),
-			printTaints(machinePool.Taints()),
-			printAZ(machinePool.AvailabilityZones()),
-			printAdditionalSecurityGroups(machinePool.AWS().AdditionalSecurityGroupIds()),
-		)
+		err = table.WriteObject(machinePool)
+		if err != nil {
+			return err
+		}
 	}
-	writer.Flush()
 
-	return nil
+	return table.Close()
 }
 
 func printAutoscaling(autoscaling *cmv1.MachinePoolAutoscaling) string {
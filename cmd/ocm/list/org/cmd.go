@@ -28,12 +28,18 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/paging"
 )
 
 var args struct {
 	parameter []string
 	header    []string
 	columns   string
+	output    string
+	sortBy    string
+	reverse   bool
+	pageSize  int
+	maxItems  int
 }
 
 var Cmd = &cobra.Command{
@@ -53,8 +59,12 @@ func init() {
 		&args.columns,
 		"columns",
 		"id, name",
-		"Comma separated list of columns to display.",
+		"Comma separated list of columns to display. Use 'preset:NAME' to select a preset "+
+			"from the table files in the '~/.config/ocm/tables' directory.",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
+	paging.AddFlags(fs, &args.pageSize, &args.maxItems)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
@@ -88,11 +98,13 @@ func run(cmd *cobra.Command, argv []string) error {
 	table, err := printer.NewTable().
 		Name("orgs").
 		Columns(args.columns).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Build(ctx)
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Write the header row:
 	err = table.WriteHeaders()
@@ -106,34 +118,34 @@ func run(cmd *cobra.Command, argv []string) error {
 	arguments.ApplyParameterFlag(request, args.parameter)
 	arguments.ApplyHeaderFlag(request, args.header)
 
-	// Send the request till we receive a page with less items than requested:
-	size := 100
-	index := 1
-	for {
+	// Send the request till we receive a page with less items than requested, or till we
+	// reach the maximum number of items:
+	loop := paging.NewLoop(args.pageSize, args.maxItems)
+	err = loop.Run(func(index, size int) (count, total int, err error) {
 		// Fetch the next page:
 		request.Size(size)
 		request.Page(index)
 		response, err := request.Send()
 		if err != nil {
-			return fmt.Errorf("can't retrieve organizations: %w", err)
+			err = fmt.Errorf("can't retrieve organizations: %w", err)
+			return
 		}
 
 		// Display the items of the fetched page:
 		response.Items().Each(func(org *amv1.Organization) bool {
+			if !loop.Accept() {
+				return false
+			}
 			err = table.WriteObject(org)
 			return err == nil
 		})
-		if err != nil {
-			break
-		}
-
-		// If the number of fetched items is less than requested, then this was the last
-		// page, otherwise process the next one:
-		if response.Size() < size {
-			break
-		}
-		index++
+		count = response.Size()
+		total = response.Total()
+		return
+	})
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return table.Close()
 }
@@ -14,13 +14,16 @@ limitations under the License.
 package region
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"text/tabwriter"
 
 	"github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/output"
 	"github.com/openshift-online/ocm-cli/pkg/provider"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +32,9 @@ var args struct {
 	ccs                bool
 	awsAccessKeyID     string
 	awsSecretAccessKey string
+	output             string
+	sortBy             string
+	reverse            bool
 }
 
 var Cmd = &cobra.Command{
@@ -71,10 +77,14 @@ func init() {
 		"",
 		"AWS Secret Access",
 	)
-
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
 	ccs := cluster.CCS{}
 	if args.provider == "aws" && args.ccs {
 		if args.awsAccessKeyID == "" {
@@ -91,6 +101,12 @@ func run(cmd *cobra.Command, argv []string) error {
 			},
 		}
 	}
+	// Load the configuration:
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
 		return fmt.Errorf("Failed to create OCM connection: %v", err)
@@ -102,31 +118,57 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
-	// Create the writer that will be used to print the tabulated results:
-	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Pager(cfg.Pager).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
 
-	//We display only the enabled region for both ccs and non ccs regions
+	// The regions available to the cloud account of the user are all usable, so there is no
+	// need to display if they are CCS only:
+	columns := "id, on_red_hat_infra, ccs_only, supports_multi_az"
 	if args.provider == "aws" && args.ccs {
-		fmt.Fprintf(writer, "ID\t\tSUPPORTS MULTI-AZ\n")
-		for _, region := range regions {
-			if !region.Enabled() {
-				continue
-			}
-			fmt.Fprintf(writer, "%s\t\t%v\n",
-				region.ID(), region.SupportsMultiAZ())
+		columns = "id, supports_multi_az"
+	}
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("regions").
+		Columns(columns).
+		Value("on_red_hat_infra", func(region *cmv1.CloudRegion) bool {
+			return !region.CCSOnly()
+		}).
+		Value("ccs_only", func(region *cmv1.CloudRegion) bool {
+			return region.CCSOnly()
+		}).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// We display only the enabled region for both ccs and non ccs regions:
+	for _, region := range regions {
+		if !region.Enabled() {
+			continue
 		}
-	} else {
-		fmt.Fprintf(writer, "ID\t\tON RED HAT INFRA\t\tCCS ONLY\t\tSUPPORTS MULTI-AZ\n")
-		for _, region := range regions {
-			if !region.Enabled() {
-				continue
-			}
-			fmt.Fprintf(writer, "%s\t\t%v\t\t%v\t\t%v\n",
-				region.ID(), !region.CCSOnly(), region.CCSOnly(), region.SupportsMultiAZ())
+		err = table.WriteObject(region)
+		if err != nil {
+			return err
 		}
-
 	}
 
-	err = writer.Flush()
-	return err
+	return table.Close()
 }
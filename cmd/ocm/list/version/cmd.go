@@ -17,10 +17,14 @@ limitations under the License.
 package version
 
 import (
+	"context"
 	"fmt"
+	"os"
 
 	"github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/output"
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +32,9 @@ var args struct {
 	defaultVersion bool
 	channelGroup   string
 	marketplaceGcp string
+	output         string
+	sortBy         string
+	reverse        bool
 }
 
 var Cmd = &cobra.Command{
@@ -63,9 +70,26 @@ func init() {
 		"",
 		"List only versions that support 'marketplace-gcp' subscription type",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Check the output format:
+	format, err := output.ParseFormat(args.output)
+	if err != nil {
+		return err
+	}
+
+	// Load the configuration:
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -80,12 +104,58 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 
 	if args.defaultVersion {
-		fmt.Println(defaultVersion)
-	} else {
-		for _, v := range versions {
-			fmt.Println(v)
+		versions = []string{defaultVersion}
+	}
+
+	// The text output of this command has always been one version per line, without headers,
+	// and there are scripts that depend on that, so we preserve it using a template that writes
+	// only the version:
+	tableFormat := string(format)
+	if format.Text() {
+		tableFormat = "go-template={{ . }}"
+	}
+
+	// Create the output printer:
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Pager(cfg.Pager).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+
+	// Create the output table:
+	table, err := printer.NewTable().
+		Name("versions").
+		Columns("id, default").
+		Value("id", func(version string) string {
+			return version
+		}).
+		Value("default", func(version string) bool {
+			return version == defaultVersion
+		}).
+		Format(tableFormat).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write the column headers:
+	err = table.WriteHeaders()
+	if err != nil {
+		return err
+	}
+
+	// Write the rows:
+	for _, version := range versions {
+		err = table.WriteObject(version)
+		if err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return table.Close()
 }
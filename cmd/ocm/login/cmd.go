@@ -24,7 +24,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openshift-online/ocm-cli/cmd/ocm/login/status"
 	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/oauth"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
 	"github.com/openshift-online/ocm-cli/pkg/properties"
@@ -53,6 +55,9 @@ var args struct {
 	persistent    bool
 	useAuthCode   bool
 	useDeviceCode bool
+	authURL       string
+	noBrowser     bool
+	authTimeout   time.Duration
 }
 
 var Cmd = &cobra.Command{
@@ -164,12 +169,63 @@ func init() {
 			"This should only be used for remote hosts and containers where browsers are "+
 			"not available. See --use-auth-code for all other scenarios.",
 	)
+	flags.StringVar(
+		&args.authURL,
+		"auth-url",
+		"",
+		"OpenID authorization URL used by '--use-auth-code'. The default is calculated from "+
+			"the token URL, replacing the trailing 'token' with 'auth'.",
+	)
+	flags.BoolVar(
+		&args.noBrowser,
+		"no-browser",
+		false,
+		"Don't open the browser when using '--use-auth-code', print the login URL instead. "+
+			"The browser used to open it must run in the same machine.",
+	)
+	flags.DurationVar(
+		&args.authTimeout,
+		"auth-timeout",
+		oauth.DefaultTimeout,
+		"Maximum time to wait for the browser login to complete when using '--use-auth-code'.",
+	)
+	Cmd.AddCommand(status.Cmd)
 }
 
 var (
-	InitiateAuthCode = authentication.InitiateAuthCode
+	InitiateAuthCode = initiateAuthCode
 )
 
+// initiateAuthCode runs the authorization code flow, using a temporary server in the loopback
+// interface to receive the code, and returns the refresh token.
+func initiateAuthCode(clientID string) (string, error) {
+	ctx := context.Background()
+	tokenURL := sdk.DefaultTokenURL
+	if args.tokenURL != "" {
+		tokenURL = args.tokenURL
+	}
+	flow, err := oauth.NewAuthCodeFlow().
+		ClientID(clientID).
+		AuthURL(args.authURL).
+		TokenURL(tokenURL).
+		Scopes(args.scopes...).
+		Insecure(args.insecure).
+		Browser(!args.noBrowser).
+		Timeout(args.authTimeout).
+		Build(ctx)
+	if err != nil {
+		return "", err
+	}
+	token, err := flow.Run(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token.RefreshToken != "" {
+		return token.RefreshToken, nil
+	}
+	return token.AccessToken, nil
+}
+
 func run(cmd *cobra.Command, argv []string) error {
 	ctx := context.Background()
 
@@ -193,16 +249,23 @@ func run(cmd *cobra.Command, argv []string) error {
 		)
 	}
 
+	if !args.useAuthCode && (args.noBrowser || args.authURL != "") {
+		return fmt.Errorf("The '--no-browser' and '--auth-url' options can only be used with '--use-auth-code'")
+	}
+
 	if args.useAuthCode {
-		fmt.Println("You will now be redirected to Red Hat SSO login")
-		// Short wait for a less jarring experience
-		time.Sleep(2 * time.Second)
-		token, err := InitiateAuthCode(oauthClientID)
+		// Use the default client of the tool unless a different one has been explicitly
+		// requested, for example for an issuer other than Red Hat SSO:
+		clientID := oauthClientID
+		if args.clientID != "" {
+			clientID = args.clientID
+		}
+		token, err := InitiateAuthCode(clientID)
 		if err != nil {
 			return fmt.Errorf("an error occurred while retrieving the token : %v", err)
 		}
 		args.token = token
-		args.clientID = oauthClientID
+		args.clientID = clientID
 	}
 
 	if args.useDeviceCode {
@@ -254,7 +317,7 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 
 	// Load the configuration:
-	cfg, err := config.Load()
+	cfg, err := config.LoadStored()
 	if err != nil {
 		return fmt.Errorf("Can't load config: %v", err)
 	}
@@ -357,7 +420,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		cfg.Password = ""
 	}
 
-	err = config.Save(cfg)
+	err = config.SaveStored(cfg)
 	if err != nil {
 		return fmt.Errorf("can't save config: %v", err)
 	}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/dump"
+	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
+	"github.com/openshift-online/ocm-cli/pkg/output"
+)
+
+var args struct {
+	output string
+}
+
+var Cmd = &cobra.Command{
+	Use:   "status",
+	Short: "Displays the login status",
+	Long: "Displays the login status without contacting the server: the URLs, the authentication " +
+		"method, the expiration times and claims of the tokens and where the configuration is " +
+		"stored. The exit code is non zero if the configuration can't be used to log in.",
+	Args: cobra.NoArgs,
+	RunE: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+	flags.StringVarP(
+		&args.output,
+		"output",
+		"o",
+		"",
+		"Output format. One of: json, go-template=..., go-template-file=..., jsonpath=... "+
+			"or jsonpath-file=.... By default a human readable description is written.",
+	)
+}
+
+func run(cmd *cobra.Command, argv []string) error {
+	// Create a context:
+	ctx := context.Background()
+
+	// Check the output format:
+	format, err := output.ParseFormat(args.output)
+	if err != nil {
+		return err
+	}
+	if args.output != "" && format != output.FormatJSON && !format.Template() {
+		return fmt.Errorf("Output format '%s' isn't supported by this command", format)
+	}
+
+	// Calculate the status:
+	status, err := config.LoadStatus(opaquetoken.Enabled())
+	if err != nil {
+		return fmt.Errorf("Can't load config: %v", err)
+	}
+
+	// Write the result:
+	switch {
+	case format.Template():
+		err = writeTemplate(ctx, status)
+	case format == output.FormatJSON:
+		err = writeJSON(status)
+	default:
+		err = writeText(os.Stdout, status)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Bye:
+	if !status.LoggedIn {
+		return &notLoggedInError{}
+	}
+	return nil
+}
+
+// notLoggedInError is the error returned when the user isn't logged in, after writing the status.
+type notLoggedInError struct{}
+
+// Error returns the message of the error.
+func (e *notLoggedInError) Error() string {
+	return "Not logged in"
+}
+
+// ExitCode returns the exit code of the command, so that scripts can check the status without
+// parsing the output.
+func (e *notLoggedInError) ExitCode() int {
+	return 1
+}
+
+func writeJSON(status *config.Status) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("Can't marshal status: %v", err)
+	}
+	return dump.Pretty(os.Stdout, data)
+}
+
+func writeTemplate(ctx context.Context, status *config.Status) error {
+	printer, err := output.NewPrinter().
+		Writer(os.Stdout).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer printer.Close()
+	template, err := printer.NewTemplate(args.output)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("Can't marshal status: %v", err)
+	}
+	return template.ExecuteJSON(data)
+}
+
+func writeText(writer io.Writer, status *config.Status) error {
+	w := tabwriter.NewWriter(writer, 8, 0, 2, ' ', 0)
+	if status.LoggedIn {
+		fmt.Fprintf(w, "Logged in:\tyes\n")
+	} else {
+		fmt.Fprintf(w, "Logged in:\tno, %s\n", status.Reason)
+	}
+	fmt.Fprintf(w, "Profile:\t%s\n", status.Profile)
+	fmt.Fprintf(w, "Storage:\t%s %s\n", status.Storage, status.Location)
+	fmt.Fprintf(w, "URL:\t%s\n", status.URL)
+	fmt.Fprintf(w, "Token URL:\t%s\n", status.TokenURL)
+	fmt.Fprintf(w, "Client ID:\t%s\n", status.ClientID)
+	fmt.Fprintf(w, "Auth method:\t%s\n", status.AuthMethod)
+	writeTokenText(w, "Access token", status.AccessToken)
+	writeTokenText(w, "Refresh token", status.RefreshToken)
+	return w.Flush()
+}
+
+func writeTokenText(w io.Writer, name string, token *config.TokenStatus) {
+	if token == nil {
+		fmt.Fprintf(w, "%s:\tnone\n", name)
+		return
+	}
+	fmt.Fprintf(w, "%s:\t%s\n", name, token.Format)
+	if token.Error != "" {
+		fmt.Fprintf(w, "  Error:\t%s\n", token.Error)
+		return
+	}
+	if token.Type != "" {
+		fmt.Fprintf(w, "  Type:\t%s\n", token.Type)
+	}
+	if token.Issuer != "" {
+		fmt.Fprintf(w, "  Issuer:\t%s\n", token.Issuer)
+	}
+	if token.Subject != "" {
+		fmt.Fprintf(w, "  Subject:\t%s\n", token.Subject)
+	}
+	switch {
+	case token.ExpiresAt == nil:
+		if token.Format == config.TokenFormatJWT {
+			fmt.Fprintf(w, "  Expires:\tnever\n")
+		}
+	case token.Expired:
+		fmt.Fprintf(
+			w, "  Expires:\t%s (expired %s ago)\n",
+			token.ExpiresAt.Format(time.RFC3339),
+			time.Since(*token.ExpiresAt).Round(time.Second),
+		)
+	default:
+		fmt.Fprintf(
+			w, "  Expires:\t%s (in %s)\n",
+			token.ExpiresAt.Format(time.RFC3339),
+			time.Until(*token.ExpiresAt).Round(time.Second),
+		)
+	}
+}
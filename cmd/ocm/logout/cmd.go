@@ -34,28 +34,40 @@ var Cmd = &cobra.Command{
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Load all the profiles. There is nothing to do if the active profile doesn't exist:
+	profiles, err := config.LoadProfiles()
+	if err != nil {
+		return fmt.Errorf("can't load configuration: %w", err)
+	}
+	if profiles == nil {
+		return nil
+	}
+	active := profiles.Active()
+	cfg, ok := profiles.Profiles[active]
+	if !ok {
+		return nil
+	}
 
-	if keyring, ok := config.IsKeyringManaged(); ok {
-		err := securestore.RemoveConfigFromKeyring(keyring)
+	// When the keyring contains only the active profile remove the complete configuration,
+	// otherwise only the settings of the active profile should be removed:
+	if keyring, ok := config.IsKeyringManaged(); ok && len(profiles.Profiles) == 1 {
+		err = securestore.RemoveConfigFromKeyring(keyring)
 		if err != nil {
 			return fmt.Errorf("can't remove configuration from keyring: %w", err)
 		}
 		return nil
 	}
-
-	// Load the configuration file:
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("can't load configuration file: %w", err)
+	if cfg == nil {
+		return nil
 	}
 
-	// Remove all the login related settings from the configuration file:
+	// Remove all the login related settings from the configuration:
 	cfg.Disarm()
 
-	// Save the configuration file:
-	err = config.Save(cfg)
+	// Save the configuration:
+	err = config.SaveStored(cfg)
 	if err != nil {
-		return fmt.Errorf("can't save configuration file: %w", err)
+		return fmt.Errorf("can't save configuration: %w", err)
 	}
 
 	return nil
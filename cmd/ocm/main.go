@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -28,6 +29,9 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/openshift-online/ocm-cli/cmd/ocm/account"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/alias"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/apiresources"
+	"github.com/openshift-online/ocm-cli/cmd/ocm/audit"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/cluster"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/completion"
 	"github.com/openshift-online/ocm-cli/cmd/ocm/config"
@@ -86,10 +90,16 @@ func init() {
 	// Add the command line flags:
 	fs := root.PersistentFlags()
 	arguments.AddDebugFlag(fs)
+	arguments.AddCassetteFlags(fs)
+	arguments.AddRetryFlags(fs)
 	arguments.AddOpaqueTokenFlag(fs)
+	arguments.AddProfileFlag(fs)
 
 	// Register the subcommands:
 	root.AddCommand(account.Cmd)
+	root.AddCommand(alias.Cmd)
+	root.AddCommand(apiresources.Cmd)
+	root.AddCommand(audit.Cmd)
 	root.AddCommand(cluster.Cmd)
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(config.Cmd)
@@ -171,6 +181,11 @@ func main() {
 	}
 	fmt.Fprintf(os.Stderr, "%s\n", message)
 
-	// Exit signaling an error:
+	// Exit signaling an error, with the specific exit code of the error if it has one, like the
+	// errors returned when waiting for a cluster:
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.ExitCode())
+	}
 	os.Exit(1)
 }
@@ -41,6 +41,9 @@ var Cmd = &cobra.Command{
 var args struct {
 	columns  string
 	nameOnly bool
+	output   string
+	sortBy   string
+	reverse  bool
 }
 
 func init() {
@@ -55,8 +58,11 @@ func init() {
 		&args.columns,
 		"columns",
 		"name, path",
-		"Comma separated list of columns to display.",
+		"Comma separated list of columns to display. Use 'preset:NAME' to select a preset "+
+			"from the table files in the '~/.config/ocm/tables' directory.",
 	)
+	output.AddFormatFlag(fs, &args.output)
+	output.AddSortFlags(fs, &args.sortBy, &args.reverse)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
@@ -95,11 +101,13 @@ func run(cmd *cobra.Command, argv []string) error {
 	table, err := printer.NewTable().
 		Name("plugins").
 		Columns(args.columns).
+		Format(args.output).
+		SortBy(args.sortBy).
+		Reverse(args.reverse).
 		Build(ctx)
 	if err != nil {
 		return err
 	}
-	defer table.Close()
 
 	// Write the column headers:
 	err = table.WriteHeaders()
@@ -118,7 +126,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		return err
 	}
 
-	return nil
+	return table.Close()
 }
 
 // Plugin contains the description fo a Plugin.
@@ -24,9 +24,12 @@ import (
 
 	"github.com/openshift-online/ocm-cli/pkg/arguments"
 	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/dryrun"
 	"github.com/openshift-online/ocm-cli/pkg/dump"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
+	"github.com/openshift-online/ocm-cli/pkg/payload"
+	"github.com/openshift-online/ocm-cli/pkg/schema"
 	"github.com/openshift-online/ocm-cli/pkg/urls"
 )
 
@@ -34,14 +37,33 @@ var args struct {
 	parameter []string
 	header    []string
 	body      string
+	template  string
+	values    []string
+	jq        string
+	raw       bool
+	dryRun    bool
+	printCurl bool
+	validate  string
 }
 
 var Cmd = &cobra.Command{
-	Use:       "post PATH",
-	Short:     "Send a POST request",
-	Long:      "Send a POST request to the given path.",
-	RunE:      run,
-	ValidArgs: urls.Resources(),
+	Use:   "post PATH [NAME=TEXT | NAME:=JSON]...",
+	Short: "Send a POST request",
+	Long: "Send a POST request to the given path.\n\n" +
+		"The body is read from the file given with '--body', from the standard input, or " +
+		"generated from the template given with '--body-template'. Fields can also be " +
+		"assigned inline: 'NAME=TEXT' assigns a string and 'NAME:=JSON' assigns a JSON " +
+		"value, like a number, a boolean or an object. Names can contain dots to assign " +
+		"nested fields.\n\n" +
+		"Before sending the request the body is checked against the specification of the " +
+		"service, to detect mistakes like misspelled field names or values of the wrong type.",
+	Example: "  # Add a label to a cluster:\n" +
+		"  ocm post /api/clusters_mgmt/v1/clusters/123/external_configuration/labels " +
+		"key=env value=prod\n\n" +
+		"  # Create an object from a template:\n" +
+		"  ocm post /api/my_service/v1/my_objects --body-template object.tmpl --set name=my_object",
+	RunE:              run,
+	ValidArgsFunction: arguments.CompletePath,
 }
 
 func init() {
@@ -49,14 +71,35 @@ func init() {
 	arguments.AddParameterFlag(fs, &args.parameter)
 	arguments.AddHeaderFlag(fs, &args.header)
 	arguments.AddBodyFlag(fs, &args.body)
+	arguments.AddBodyTemplateFlags(fs, &args.template, &args.values)
+	dump.AddQueryFlags(fs, &args.jq, &args.raw)
+	dryrun.AddFlags(fs, &args.dryRun, &args.printCurl)
+	schema.AddValidateFlag(fs, &args.validate)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	// Separate the inline field assignments from the path:
+	argv, fields := payload.SplitFields(argv)
 	path, err := urls.Expand(argv)
 	if err != nil {
 		return fmt.Errorf("Could not create URI: %v", err)
 	}
 
+	// Parse the jq expression given by the user, if any:
+	var query *dump.Query
+	if args.jq != "" {
+		query, err = dump.ParseQuery(args.jq, args.raw)
+		if err != nil {
+			return err
+		}
+	} else if args.raw {
+		return fmt.Errorf("Option '--raw-output' can only be used together with '--jq'")
+	}
+	err = schema.CheckMode(args.validate)
+	if err != nil {
+		return err
+	}
+
 	// Load the configuration file:
 	cfg, err := config.Load()
 	if err != nil {
@@ -66,8 +109,23 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("Not logged in, run the 'login' command")
 	}
 
-	// Create the client for the OCM API:
-	connection, err := ocm.NewConnection().Build()
+	// Read the body and check it against the specification of the service:
+	body, err := arguments.ReadBodyFlags(args.body, args.template, args.values, fields)
+	if err != nil {
+		return fmt.Errorf("Can't read body: %v", err)
+	}
+	err = arguments.ValidateBody(os.Stderr, args.validate, "POST", path, body)
+	if err != nil {
+		return fmt.Errorf("Invalid body: %v", err)
+	}
+
+	// Create the client for the OCM API. In dry run mode the requests are described instead
+	// of being sent:
+	builder := ocm.NewConnection()
+	if args.dryRun || args.printCurl {
+		builder.TransportWrapper(dryrun.Wrapper(os.Stdout, args.printCurl, cfg.TokenURL))
+	}
+	connection, err := builder.Build()
 	if err != nil {
 		return fmt.Errorf("Failed to create OCM connection: %v", err)
 	}
@@ -82,10 +140,7 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 	arguments.ApplyParameterFlag(request, args.parameter)
 	arguments.ApplyHeaderFlag(request, args.header)
-	err = arguments.ApplyBodyFlag(request, args.body)
-	if err != nil {
-		return fmt.Errorf("Can't read body: %v", err)
-	}
+	request.Bytes(body)
 
 	// Send the request:
 	response, err := request.Send()
@@ -93,8 +148,10 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("Can't send request: %v", err)
 	}
 	status := response.Status()
-	body := response.Bytes()
-	if status < 400 {
+	body = response.Bytes()
+	if status < 400 && query != nil {
+		err = query.Pretty(os.Stdout, body)
+	} else if status < 400 {
 		err = dump.Pretty(os.Stdout, body)
 	} else {
 		err = dump.Pretty(os.Stderr, body)
@@ -37,14 +37,18 @@ var args struct {
 	signature bool
 	refresh   bool
 	generate  bool
+
+	execCredential bool
 }
 
 var Cmd = &cobra.Command{
 	Use:   "token",
 	Short: "Generates a token",
-	Long:  "Uses the stored credentials to generate a token.",
-	Args:  cobra.NoArgs,
-	RunE:  run,
+	Long: "Uses the stored credentials to generate a token.\n\n" +
+		"Use '--exec-credential' to use this command as a Kubernetes credential plugin, and " +
+		"'ocm token helper get' to use it as a git style credential helper.",
+	Args: cobra.NoArgs,
+	RunE: run,
 }
 
 func init() {
@@ -79,6 +83,15 @@ func init() {
 		false,
 		"Generate a new token.",
 	)
+	flags.BoolVar(
+		&args.execCredential,
+		"exec-credential",
+		false,
+		"Print the access token as a Kubernetes 'ExecCredential' object, so that the command "+
+			"can be used as a credential plugin by tools that support the "+
+			"'client.authentication.k8s.io/v1' exec protocol. Can be combined with '--generate'.",
+	)
+	Cmd.AddCommand(helperCmd)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
@@ -100,53 +113,22 @@ func run(cmd *cobra.Command, argv []string) error {
 	if count > 1 {
 		return fmt.Errorf("Options '--payload', '--header', '--signature', and '--generate' are mutually exclusive")
 	}
+	if args.execCredential && (args.header || args.payload || args.signature || args.refresh) {
+		return fmt.Errorf(
+			"Option '--exec-credential' can't be used with '--payload', '--header', " +
+				"'--signature' or '--refresh'",
+		)
+	}
 
-	// Load the configuration file:
-	cfg, err := config.Load()
+	// Get the tokens, refreshing them if needed:
+	accessToken, refreshToken, err := loadTokens(args.generate)
 	if err != nil {
-		return fmt.Errorf("Can't load config file: %v", err)
+		return err
 	}
 
-	var accessToken string
-	var refreshToken string
-
-	haveOpaqueToken := opaquetoken.Enabled() || (cfg != nil && cfg.OpaqueToken)
-
-	if haveOpaqueToken {
-		if args.generate {
-			return fmt.Errorf("The '--generate' option is not supported with opaque tokens")
-		}
-		accessToken = cfg.AccessToken
-		refreshToken = cfg.RefreshToken
-	} else {
-		// Create the client for the OCM API:
-		connection, err := ocm.NewConnection().Build()
-		if err != nil {
-			return fmt.Errorf("Failed to create OCM connection: %v", err)
-		}
-		defer connection.Close()
-
-		if args.generate {
-			// Get new tokens:
-			accessToken, refreshToken, err = connection.Tokens(15 * time.Minute)
-			if err != nil {
-				return fmt.Errorf("Can't get new tokens: %v", err)
-			}
-		} else {
-			// Get the tokens:
-			accessToken, refreshToken, err = connection.Tokens()
-			if err != nil {
-				return fmt.Errorf("Can't get token: %v", err)
-			}
-		}
-
-		// Save the potentially refreshed tokens:
-		cfg.AccessToken = accessToken
-		cfg.RefreshToken = refreshToken
-		err = config.Save(cfg)
-		if err != nil {
-			return fmt.Errorf("Can't save config file: %v", err)
-		}
+	// Write the credential for the exec protocol if requested:
+	if args.execCredential {
+		return writeExecCredential(os.Stdout, accessToken)
 	}
 
 	// Select the token according to the options:
@@ -203,3 +185,61 @@ func run(cmd *cobra.Command, argv []string) error {
 	// Bye:
 	return nil
 }
+
+// loadTokens loads the access and refresh tokens, refreshing them if needed, or generating new ones
+// if requested, and saves the potentially refreshed tokens to the configuration. Opaque tokens are
+// returned as they are, as they can't be refreshed.
+func loadTokens(generate bool) (accessToken, refreshToken string, err error) {
+	// Load the configuration file:
+	cfg, err := config.Load()
+	if err != nil {
+		err = fmt.Errorf("Can't load config file: %v", err)
+		return
+	}
+
+	haveOpaqueToken := opaquetoken.Enabled() || (cfg != nil && cfg.OpaqueToken)
+
+	if haveOpaqueToken {
+		if generate {
+			err = fmt.Errorf("The '--generate' option is not supported with opaque tokens")
+			return
+		}
+		accessToken = cfg.AccessToken
+		refreshToken = cfg.RefreshToken
+		return
+	}
+
+	// Create the client for the OCM API:
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		err = fmt.Errorf("Failed to create OCM connection: %v", err)
+		return
+	}
+	defer connection.Close()
+
+	if generate {
+		// Get new tokens:
+		accessToken, refreshToken, err = connection.Tokens(15 * time.Minute)
+		if err != nil {
+			err = fmt.Errorf("Can't get new tokens: %v", err)
+			return
+		}
+	} else {
+		// Get the tokens:
+		accessToken, refreshToken, err = connection.Tokens()
+		if err != nil {
+			err = fmt.Errorf("Can't get token: %v", err)
+			return
+		}
+	}
+
+	// Save the potentially refreshed tokens:
+	cfg.AccessToken = accessToken
+	cfg.RefreshToken = refreshToken
+	err = config.Save(cfg)
+	if err != nil {
+		err = fmt.Errorf("Can't save config file: %v", err)
+		return
+	}
+	return
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that makes the access token available to other tools using standard
+// protocols: the Kubernetes exec credential protocol and the protocol of git credential helpers.
+
+package token
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+)
+
+// execCredential is the object written for the 'client.authentication.k8s.io/v1' exec protocol.
+type execCredential struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Status     execCredentialStatus `json:"status"`
+}
+
+type execCredentialStatus struct {
+	ExpirationTimestamp string `json:"expirationTimestamp,omitempty"`
+	Token               string `json:"token"`
+}
+
+// writeExecCredential writes the given access token as an exec credential. The expiration
+// timestamp is extracted from the `exp` claim of the token, and omitted if the token isn't a JWT
+// or doesn't expire.
+func writeExecCredential(writer io.Writer, accessToken string) error {
+	credential := &execCredential{
+		APIVersion: "client.authentication.k8s.io/v1",
+		Kind:       "ExecCredential",
+		Status: execCredentialStatus{
+			Token: accessToken,
+		},
+	}
+	expiry, expires, err := tokenExpiry(accessToken)
+	if err != nil {
+		return err
+	}
+	if expires {
+		credential.Status.ExpirationTimestamp = expiry.UTC().Format(time.RFC3339)
+	}
+	data, err := json.MarshalIndent(credential, "", "  ") // #nosec G117
+	if err != nil {
+		return fmt.Errorf("Can't marshal credential: %v", err)
+	}
+	_, err = fmt.Fprintf(writer, "%s\n", data)
+	return err
+}
+
+// tokenExpiry returns the time when the given token expires. Tokens that aren't JWTs, like opaque
+// tokens, are considered as not expiring.
+func tokenExpiry(text string) (expiry time.Time, expires bool, err error) {
+	if !config.IsJWTToken(text) {
+		return
+	}
+	token, err := config.ParseToken(text)
+	if err != nil {
+		err = fmt.Errorf("Can't parse token: %v", err)
+		return
+	}
+	expiry, expires, err = config.TokenExpiry(token)
+	if err != nil {
+		err = fmt.Errorf("Can't extract expiration from token: %v", err)
+	}
+	return
+}
+
+var helperCmd = &cobra.Command{
+	Use:   "helper",
+	Short: "Credential helper",
+	Long: "Provides the access token using the protocol of git credential helpers. The " +
+		"attributes of the request, like 'protocol' and 'host', are read from the standard " +
+		"input, one 'key=value' pair per line, and the credential is written to the standard " +
+		"output in the same format, with the access token in the 'password' attribute. If the " +
+		"'host' attribute doesn't match the host of the configured API URL nothing is written, " +
+		"so that other helpers can be used.",
+	Args: cobra.NoArgs,
+}
+
+var helperGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Prints the credential",
+	Long:  "Reads the attributes of the request and prints the credential, refreshing the tokens if needed.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, argv []string) error {
+		return runHelperGet(os.Stdin, os.Stdout)
+	},
+}
+
+// The 'store' and 'erase' operations are called by git after using the credential. The tokens
+// are already managed by this tool, so there is nothing to do other than consuming the input.
+var helperStoreCmd = &cobra.Command{
+	Use:    "store",
+	Short:  "Does nothing, the tokens are already stored",
+	Args:   cobra.NoArgs,
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, argv []string) error {
+		_, err := readHelperAttributes(os.Stdin)
+		return err
+	},
+}
+
+var helperEraseCmd = &cobra.Command{
+	Use:    "erase",
+	Short:  "Does nothing, use 'ocm logout' to remove the tokens",
+	Args:   cobra.NoArgs,
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, argv []string) error {
+		_, err := readHelperAttributes(os.Stdin)
+		return err
+	},
+}
+
+func init() {
+	helperCmd.AddCommand(helperGetCmd)
+	helperCmd.AddCommand(helperStoreCmd)
+	helperCmd.AddCommand(helperEraseCmd)
+}
+
+func runHelperGet(reader io.Reader, writer io.Writer) error {
+	// Read the attributes of the request:
+	attributes, err := readHelperAttributes(reader)
+	if err != nil {
+		return err
+	}
+
+	// Check that the request is for the configured server. Note that this is not an error,
+	// the helper just doesn't know the credential.
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("Can't load config file: %v", err)
+	}
+	if cfg == nil {
+		return fmt.Errorf("Not logged in, run the 'login' command")
+	}
+	gatewayURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return fmt.Errorf("Can't parse URL '%s': %v", cfg.URL, err)
+	}
+	host, ok := attributes["host"]
+	if ok && host != gatewayURL.Host {
+		return nil
+	}
+
+	// Get the tokens, refreshing them if needed:
+	accessToken, _, err := loadTokens(false)
+	if err != nil {
+		return err
+	}
+	expiry, expires, err := tokenExpiry(accessToken)
+	if err != nil {
+		return err
+	}
+
+	// Write the credential:
+	lines := []string{
+		fmt.Sprintf("protocol=%s", gatewayURL.Scheme),
+		fmt.Sprintf("host=%s", gatewayURL.Host),
+		"username=token",
+		fmt.Sprintf("password=%s", accessToken),
+	}
+	if expires {
+		lines = append(lines, fmt.Sprintf("password_expiry_utc=%d", expiry.Unix()))
+	}
+	_, err = fmt.Fprintf(writer, "%s\n", strings.Join(lines, "\n"))
+	return err
+}
+
+// readHelperAttributes reads the 'key=value' attributes of a credential helper request. The
+// request ends with an empty line or with the end of the input.
+func readHelperAttributes(reader io.Reader) (result map[string]string, err error) {
+	result = map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			err = fmt.Errorf("Can't parse credential attribute '%s', expected 'key=value'", line)
+			return
+		}
+		result[key] = value
+	}
+	err = scanner.Err()
+	return
+}
@@ -29,10 +29,14 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/spf13/pflag"
 
+	"github.com/openshift-online/ocm-cli/pkg/cassette"
 	"github.com/openshift-online/ocm-cli/pkg/cluster"
+	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/debug"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
 	"github.com/openshift-online/ocm-cli/pkg/output"
+	"github.com/openshift-online/ocm-cli/pkg/payload"
+	"github.com/openshift-online/ocm-cli/pkg/retry"
 )
 
 type FilePath string
@@ -59,9 +63,25 @@ func AddDebugFlag(fs *pflag.FlagSet) {
 	debug.AddFlag(fs)
 }
 
+// AddCassetteFlags adds the '--record' and '--replay' flags to the given set of command line flags.
+func AddCassetteFlags(fs *pflag.FlagSet) {
+	cassette.AddFlags(fs)
+}
+
+// AddRetryFlags adds the '--retries' and '--timeout' flags to the given set of command line flags.
+func AddRetryFlags(fs *pflag.FlagSet) {
+	retry.AddFlags(fs)
+}
+
 // AddOpaqueTokenFlag adds the '--opaque-token' flag to the given set of command line flags.
 func AddOpaqueTokenFlag(fs *pflag.FlagSet) {
 	opaquetoken.AddFlag(fs)
+	config.AddFlagOverride(fs, "opaque-token", "opaque_token")
+}
+
+// AddProfileFlag adds the '--profile' flag to the given set of command line flags.
+func AddProfileFlag(fs *pflag.FlagSet) {
+	config.AddProfileFlag(fs)
 }
 
 // AddParameterFlag adds the '--parameter' flag to the given set of command line flags.
@@ -103,6 +123,27 @@ func AddBodyFlag(fs *pflag.FlagSet, value *string) {
 	)
 }
 
+// AddBodyTemplateFlags adds the '--body-template' and '--set' flags to the given set of command
+// line flags.
+func AddBodyTemplateFlags(fs *pflag.FlagSet, template *string, values *[]string) {
+	fs.StringVar(
+		template,
+		"body-template",
+		"",
+		"Name of the file containing a Go template used to generate the request body. "+
+			"The template can use the values given with '--set' as '{{ .name }}', the "+
+			"environment variables as '{{ env \"NAME\" }}' and the 'json' function to "+
+			"quote values.",
+	)
+	fs.StringArrayVar(
+		values,
+		"set",
+		nil,
+		"Value used by the body template, in the 'name=value' format. Can be used "+
+			"multiple times to specify multiple values.",
+	)
+}
+
 // AddCCSFlagsWithoutAccountID is sufficient for list regions command.
 func AddCCSFlagsWithoutAccountID(fs *pflag.FlagSet, value *cluster.CCS) {
 	fs.BoolVar(
@@ -464,6 +505,41 @@ func ApplyBodyFlag(request *sdk.Request, value string) error {
 	return nil
 }
 
+// ReadBodyFlags calculates the body of a request from the '--body' or '--body-template' flags
+// and from the inline field assignments given as arguments, like 'name=my_cluster' or
+// 'nodes.compute:=3'. The assignments are applied on top of the body from the file or template,
+// or on top of an empty object if there is none. When there are no template and no assignments
+// the body is read from the file or from the standard input, like in ApplyBodyFlag.
+func ReadBodyFlags(file, template string, values, fields []string) (data []byte, err error) {
+	if file != "" && template != "" {
+		err = fmt.Errorf("options '--body' and '--body-template' can't be used together")
+		return
+	}
+	if template == "" && len(values) > 0 {
+		err = fmt.Errorf("option '--set' can only be used together with '--body-template'")
+		return
+	}
+	switch {
+	case template != "":
+		data, err = payload.Render(template, values)
+	case file != "":
+		// #nosec G304
+		data, err = os.ReadFile(file)
+	case len(fields) == 0:
+		if output.IsTerminal(os.Stdin) && output.IsTerminal(os.Stderr) {
+			fmt.Fprintln(os.Stderr, "No --body file specified, reading request body from stdin:")
+		}
+		data, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return
+	}
+	if len(fields) > 0 {
+		data, err = payload.Apply(data, fields)
+	}
+	return
+}
+
 // ApplyPathArg applies the value of the path given in the command line to the given request.
 func ApplyPathArg(request *sdk.Request, value string) error {
 	parsed, err := url.Parse(value)
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that use the OpenAPI specifications included in the SDK to
+// validate request bodies and to complete paths.
+
+package arguments
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	asv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	jqv1 "github.com/openshift-online/ocm-sdk-go/jobqueue/v1"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	sbv1 "github.com/openshift-online/ocm-sdk-go/statusboard/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/paging"
+	"github.com/openshift-online/ocm-cli/pkg/schema"
+	"github.com/openshift-online/ocm-cli/pkg/urls"
+)
+
+// LoadCatalog returns the catalog created from the OpenAPI specifications of the services included
+// in the SDK. The specifications are parsed only the first time that this is called.
+func LoadCatalog() (*schema.Catalog, error) {
+	return loadCatalog()
+}
+
+var loadCatalog = sync.OnceValues(func() (*schema.Catalog, error) {
+	return schema.NewCatalog().
+		Spec(cmv1.OpenAPI).
+		Spec(amv1.OpenAPI).
+		Spec(asv1.OpenAPI).
+		Spec(slv1.OpenAPI).
+		Spec(jqv1.OpenAPI).
+		Spec(sbv1.OpenAPI).
+		Build()
+})
+
+// ValidateBody checks the body of a request with the given method and path against the
+// specification of the service, according to the validation mode given with the '--validate'
+// flag. In the strict mode problems are returned as an error. In the warn mode they are written
+// to the given writer and the request is considered valid. Bodies for paths that aren't described
+// by the specifications are left to the server.
+func ValidateBody(writer io.Writer, mode, method, path string, body []byte) error {
+	if mode == schema.ModeNone || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	catalog, err := LoadCatalog()
+	if err != nil {
+		return err
+	}
+	problems, found, err := catalog.Validate(method, path, body)
+	var lines []string
+	switch {
+	case err != nil:
+		lines = append(lines, "  - "+err.Error())
+	case found:
+		for _, problem := range problems {
+			lines = append(lines, "  - "+problem.String())
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	if mode == schema.ModeStrict {
+		return fmt.Errorf(
+			"request body doesn't match the specification, use '--validate=warn' to send "+
+				"it anyway:\n%s",
+			strings.Join(lines, "\n"),
+		)
+	}
+	fmt.Fprintf(
+		writer,
+		"Warning: request body doesn't match the specification:\n%s\n",
+		strings.Join(lines, "\n"),
+	)
+	return nil
+}
+
+// CompletePath completes the first argument of the commands that send requests, like 'ocm get'.
+// Arguments that start with a slash are completed with the segments of the paths described by the
+// specifications, and with the identifiers of the items of the collection retrieved from the
+// server when the next segment is an identifier. Other arguments are completed with the names of
+// the resource aliases.
+func CompletePath(cmd *cobra.Command, args []string,
+	toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 || !strings.HasPrefix(toComplete, "/") {
+		return urls.CompleteResources(cmd, args, toComplete)
+	}
+	catalog, err := LoadCatalog()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions, collections := catalog.CompletePath(toComplete)
+	for _, collection := range collections {
+		items, err := completeItems(collection)
+		if err != nil {
+			cobra.CompErrorln(fmt.Sprintf("unable to get items of '%s': %v", collection, err))
+			continue
+		}
+		for _, item := range items {
+			path := collection + "/" + item.ID
+			if !strings.HasPrefix(path, toComplete) {
+				continue
+			}
+			if item.Name != "" && item.Name != item.ID {
+				path += "\t" + item.Name
+			}
+			completions = append(completions, path)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionItem contains the fields of the items of collections used for completions.
+type completionItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// completeItems retrieves the first page of the given collection and returns its items.
+func completeItems(collection string) (result []*completionItem, err error) {
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		return
+	}
+	defer connection.Close()
+	response, err := connection.Get().
+		Path(collection).
+		Parameter("size", paging.DefaultSize).
+		Send()
+	if err != nil {
+		return
+	}
+	if response.Status() >= 400 {
+		err = fmt.Errorf("server returned status %d", response.Status())
+		return
+	}
+	page, err := paging.ParsePage(response.Bytes())
+	if err != nil {
+		return
+	}
+	for _, data := range page.Items {
+		item := &completionItem{}
+		err = json.Unmarshal(data, item)
+		if err != nil {
+			return
+		}
+		if item.ID != "" {
+			result = append(result, item)
+		}
+	}
+	return
+}
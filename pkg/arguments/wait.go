@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the flags used by the commands that can wait till a cluster reaches a state.
+
+package arguments
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/cluster"
+)
+
+// WaitArgs contains the values of the flags added by AddWaitFlags.
+type WaitArgs struct {
+	Wait    bool
+	Timeout time.Duration
+	Logs    bool
+
+	// Names of the flags that enable waiting and that limit the time waiting:
+	name        string
+	timeoutName string
+}
+
+// AddWaitFlags adds the flags that make a command wait till the cluster reaches a state. The name
+// of the flag that enables waiting is usually 'wait', and the name of the flag that limits the
+// total time waiting is derived from it, for example '--wait-timeout', so that it doesn't hide
+// the global '--timeout' flag.
+func AddWaitFlags(fs *pflag.FlagSet, name string, value *WaitArgs) {
+	value.name = name
+	value.timeoutName = name + "-timeout"
+	fs.BoolVar(
+		&value.Wait,
+		name,
+		false,
+		"Wait till the operation completes, displaying the changes of state of the cluster. "+
+			"The exit code is 2 if the cluster fails, and 3 if the timeout expires.",
+	)
+	fs.DurationVar(
+		&value.Timeout,
+		value.timeoutName,
+		0,
+		fmt.Sprintf(
+			"Maximum time to wait when '--%s' is used, for example '1h'. The default is to "+
+				"wait without limit.",
+			name,
+		),
+	)
+	fs.BoolVar(
+		&value.Logs,
+		"logs",
+		false,
+		fmt.Sprintf(
+			"Used together with '--%s', write the install or uninstall logs while the cluster "+
+				"is installing or uninstalling.",
+			name,
+		),
+	)
+}
+
+// Check checks that the timeout and '--logs' flags are only used together with the flag that
+// enables waiting.
+func (a *WaitArgs) Check(fs *pflag.FlagSet) error {
+	if a.Wait {
+		return nil
+	}
+	for _, flag := range []string{a.timeoutName, "logs"} {
+		if fs.Changed(flag) {
+			return fmt.Errorf("Option '--%s' can only be used together with '--%s'", flag, a.name)
+		}
+	}
+	return nil
+}
+
+// Run waits till the cluster reaches the given target, if waiting was enabled. The progress is
+// written to the standard error, so that the standard output contains only the result of the
+// command.
+func (a *WaitArgs) Run(ctx context.Context, client *cmv1.ClustersClient, clusterID string,
+	target cluster.WatchTarget) error {
+	if !a.Wait {
+		return nil
+	}
+	watcher, err := cluster.NewWatcher().
+		Client(client).
+		Cluster(clusterID).
+		Target(target).
+		Timeout(a.Timeout).
+		Logs(a.Logs).
+		Writer(os.Stderr).
+		Build()
+	if err != nil {
+		return err
+	}
+	return watcher.Run(ctx)
+}
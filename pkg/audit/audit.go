@@ -0,0 +1,363 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit contains the transport wrapper that appends a record of each request that changes
+// something in the server to a local file, the audit log, and the functions that read and filter
+// those records. The audit log is only written when the 'audit_log' setting of the configuration
+// contains the name of the file.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	homedir "github.com/mitchellh/go-homedir"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/roundtrip"
+)
+
+// Record is the entry of the audit log that describes one request.
+type Record struct {
+	Time      time.Time `json:"time"`
+	User      string    `json:"user,omitempty"`
+	Command   string    `json:"command"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Status    int       `json:"status,omitempty"`
+	RequestID string    `json:"request_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// ExpandFile returns the name of the audit log file given in the configuration, with the '~'
+// prefix replaced by the home directory of the user.
+func ExpandFile(value string) (result string, err error) {
+	result, err = homedir.Expand(value)
+	if err != nil {
+		err = fmt.Errorf("can't expand audit log file name '%s': %w", value, err)
+	}
+	return
+}
+
+// Wrapper returns a transport wrapper that appends to the given file a record for each request
+// that uses a method other than GET, HEAD or OPTIONS. The command is the command line that
+// caused the requests. Requests for the given token URL aren't recorded, because they don't
+// change anything in the server.
+func Wrapper(file, tokenURL string, command []string) (result func(http.RoundTripper) http.RoundTripper,
+	err error) {
+	// Check that the file can be written before sending any request, so that changes are never
+	// made without a record of them:
+	dir := filepath.Dir(file)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		err = fmt.Errorf("can't create directory for audit log: %w", err)
+		return
+	}
+	writer, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304
+	if err != nil {
+		err = fmt.Errorf("can't open audit log: %w", err)
+		return
+	}
+	err = writer.Close()
+	if err != nil {
+		return
+	}
+	line := CommandLine(command)
+	result = func(wrapped http.RoundTripper) http.RoundTripper {
+		return &transport{
+			wrapped:  wrapped,
+			file:     file,
+			tokenURL: tokenURL,
+			command:  line,
+		}
+	}
+	return
+}
+
+// transport is the round tripper returned by the wrapper.
+type transport struct {
+	wrapped  http.RoundTripper
+	file     string
+	tokenURL string
+	command  string
+}
+
+// lock serializes the writes of the records from the same process, writes from different
+// processes are separated because the file is opened in append mode.
+var lock = &sync.Mutex{}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *transport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	if !Mutating(request.Method) || roundtrip.IsTokenRequest(request, t.tokenURL) {
+		return t.wrapped.RoundTrip(request)
+	}
+	record := &Record{
+		Time:      time.Now().UTC(),
+		User:      tokenUser(request.Header.Get("Authorization")),
+		Command:   t.command,
+		Method:    request.Method,
+		Path:      request.URL.RequestURI(),
+		RequestID: request.Header.Get("X-Request-Id"),
+	}
+	response, err = t.wrapped.RoundTrip(request)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = response.StatusCode
+		if record.RequestID == "" {
+			record.RequestID = responseID(response)
+		}
+	}
+
+	// Failing to write the record doesn't fail the request, because it has already been sent,
+	// but the user needs to know:
+	writeErr := t.write(record)
+	if writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: can't write audit log: %v\n", writeErr)
+	}
+	return
+}
+
+// write appends the record to the audit log.
+func (t *transport) write(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	lock.Lock()
+	defer lock.Unlock()
+	writer, err := os.OpenFile(t.file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	if err != nil {
+		writer.Close() // nolint
+		return err
+	}
+	return writer.Close()
+}
+
+// Mutating checks if requests with the given method can change something in the server, and
+// should therefore be recorded.
+func Mutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// tokenUser extracts the name of the user from the bearer token contained in the given
+// authorization header. It uses the 'username' claim when it is available, as it is more
+// readable, and the 'sub' claim otherwise. Tokens that aren't JWTs, like opaque tokens, result in
+// an empty string.
+func tokenUser(header string) string {
+	scheme, text, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	token, err := config.ParseToken(text)
+	if err != nil {
+		return ""
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	for _, name := range []string{"username", "preferred_username", "sub"} {
+		value, ok := claims[name].(string)
+		if ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// responseID returns the identifier that the server assigned to the request. It is taken from
+// the 'X-Operation-Id' or 'X-Request-Id' headers, or from the 'operation_id' field of error
+// responses. If the body of the response can't be read the result is empty, and the body is
+// replaced so that the caller reads the same data and gets the same error.
+func responseID(response *http.Response) (result string) {
+	for _, name := range []string{"X-Operation-Id", "X-Request-Id"} {
+		result = response.Header.Get(name)
+		if result != "" {
+			return
+		}
+	}
+	if response.StatusCode < 400 || response.Body == nil {
+		return
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		response.Body = struct {
+			io.Reader
+			io.Closer
+		}{
+			Reader: io.MultiReader(bytes.NewReader(body), response.Body),
+			Closer: response.Body,
+		}
+		return
+	}
+	response.Body.Close() // nolint
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	var data struct {
+		OperationID string `json:"operation_id"`
+	}
+	if json.Unmarshal(body, &data) == nil {
+		result = data.OperationID
+	}
+	return
+}
+
+// secretFlags are the flags whose values are redacted in the command line.
+var secretFlags = map[string]bool{
+	"--client-secret": true,
+	"--password":      true,
+	"--token":         true,
+}
+
+// CommandLine returns the given command line as a single string, with the values of the flags
+// that contain credentials redacted and the arguments that contain spaces quoted.
+func CommandLine(args []string) string {
+	result := make([]string, len(args))
+	redactNext := false
+	for i, arg := range args {
+		name, _, hasValue := strings.Cut(arg, "=")
+		switch {
+		case redactNext:
+			arg = config.Redacted
+			redactNext = false
+		case secretFlags[name] && hasValue:
+			arg = name + "=" + config.Redacted
+		case secretFlags[name]:
+			redactNext = true
+		}
+		if strings.ContainsAny(arg, " \t\n\"'") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		result[i] = arg
+	}
+	return strings.Join(result, " ")
+}
+
+// Query selects records of the audit log. Fields with zero values match all the records.
+type Query struct {
+	Since  time.Time
+	User   string
+	Method string
+	Path   string
+}
+
+// Match checks if the given record is selected by the query. The method is compared ignoring
+// case, and the path matches if it is a prefix of the path of the record.
+func (q *Query) Match(record *Record) bool {
+	if !q.Since.IsZero() && record.Time.Before(q.Since) {
+		return false
+	}
+	if q.User != "" && record.User != q.User {
+		return false
+	}
+	if q.Method != "" && !strings.EqualFold(record.Method, q.Method) {
+		return false
+	}
+	if q.Path != "" && !strings.HasPrefix(record.Path, q.Path) {
+		return false
+	}
+	return true
+}
+
+// Read reads the records of the given audit log that match the query, in the order they were
+// written. A file that doesn't exist is equivalent to an empty one.
+func Read(file string, query *Query) (result []*Record, err error) {
+	reader, err := os.Open(file) // #nosec G304
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf("can't open audit log: %w", err)
+		return
+	}
+	defer reader.Close()
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	number := 0
+	for scanner.Scan() {
+		number++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := &Record{}
+		err = json.Unmarshal(line, record)
+		if err != nil {
+			err = fmt.Errorf("can't parse line %d of audit log '%s': %w", number, file, err)
+			return
+		}
+		if query == nil || query.Match(record) {
+			result = append(result, record)
+		}
+	}
+	err = scanner.Err()
+	if err != nil {
+		err = fmt.Errorf("can't read audit log '%s': %w", file, err)
+	}
+	return
+}
+
+// ParseSince parses the value of the '--since' flag. It can be a duration relative to the given
+// time, like '90m', '24h' or '7d', a date like '2026-01-31', or a complete RFC 3339 time.
+func ParseSince(text string, now time.Time) (result time.Time, err error) {
+	if days, ok := strings.CutSuffix(text, "d"); ok {
+		count, atoiErr := strconv.Atoi(days)
+		if atoiErr == nil && count >= 0 {
+			result = now.AddDate(0, 0, -count)
+			return
+		}
+	}
+	duration, err := time.ParseDuration(text)
+	if err == nil && duration >= 0 {
+		result = now.Add(-duration)
+		return
+	}
+	result, err = time.ParseInLocation(time.DateOnly, text, now.Location())
+	if err == nil {
+		return
+	}
+	result, err = time.Parse(time.RFC3339, text)
+	if err != nil {
+		err = fmt.Errorf(
+			"time '%s' isn't valid, it should be a duration like '24h' or '7d', a date like "+
+				"'2026-01-31' or a time like '2026-01-31T12:00:00Z'",
+			text,
+		)
+	}
+	return
+}
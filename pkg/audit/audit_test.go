@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing/iotest"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+
+	"github.com/openshift-online/ocm-cli/pkg/roundtrip"
+)
+
+var _ = Describe("Audit", func() {
+	var file string
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "audit", "audit.jsonl")
+	})
+
+	// makeToken creates an unsigned token with the given claims.
+	makeToken := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).
+			SignedString(jwt.UnsafeAllowNoneSignatureType)
+		Expect(err).ToNot(HaveOccurred())
+		return token
+	}
+
+	// send sends a request through the audit wrapper to a transport that responds with the given
+	// status and headers.
+	send := func(method, url string, status int, header http.Header) {
+		wrapper, err := Wrapper(file, "https://sso.example.com/token", []string{"ocm", "delete", url})
+		Expect(err).ToNot(HaveOccurred())
+		transport := wrapper(roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: status,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}))
+		request, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Authorization", "Bearer "+makeToken(jwt.MapClaims{
+			"sub":      "my-subject",
+			"username": "my-user",
+		}))
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(status))
+	}
+
+	It("Records mutating requests", func() {
+		send(
+			http.MethodDelete,
+			"https://api.example.com/api/clusters_mgmt/v1/clusters/123",
+			http.StatusNoContent,
+			http.Header{"X-Operation-Id": []string{"my-operation"}},
+		)
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		record := records[0]
+		Expect(record.Time).To(BeTemporally("~", time.Now(), time.Minute))
+		Expect(record.User).To(Equal("my-user"))
+		Expect(record.Command).To(Equal(
+			"ocm delete https://api.example.com/api/clusters_mgmt/v1/clusters/123",
+		))
+		Expect(record.Method).To(Equal(http.MethodDelete))
+		Expect(record.Path).To(Equal("/api/clusters_mgmt/v1/clusters/123"))
+		Expect(record.Status).To(Equal(http.StatusNoContent))
+		Expect(record.RequestID).To(Equal("my-operation"))
+	})
+
+	It("Doesn't record other requests", func() {
+		send(http.MethodGet, "https://api.example.com/api/clusters_mgmt/v1/clusters", http.StatusOK, nil)
+		send(http.MethodPost, "https://sso.example.com/token", http.StatusOK, nil)
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	It("Takes the request identifier from error responses", func() {
+		wrapper, err := Wrapper(file, "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		transport := wrapper(roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{ "operation_id": "my-operation" }`)),
+			}, nil
+		}))
+		request, err := http.NewRequest(http.MethodPatch, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{ "operation_id": "my-operation" }`))
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].RequestID).To(Equal("my-operation"))
+		Expect(records[0].User).To(BeEmpty())
+	})
+
+	It("Records the request even if the body of the error response can't be read", func() {
+		wrapper, err := Wrapper(file, "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		transport := wrapper(roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Header:     http.Header{},
+				Body: io.NopCloser(io.MultiReader(
+					strings.NewReader(`{ "operation_id"`),
+					iotest.ErrReader(errors.New("connection reset by peer")),
+				)),
+			}, nil
+		}))
+		request, err := http.NewRequest(http.MethodPost, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).To(MatchError("connection reset by peer"))
+		Expect(string(body)).To(Equal(`{ "operation_id"`))
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Status).To(Equal(http.StatusInternalServerError))
+		Expect(records[0].RequestID).To(BeEmpty())
+	})
+
+	It("Records requests that fail", func() {
+		wrapper, err := Wrapper(file, "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		transport := wrapper(roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}))
+		request, err := http.NewRequest(http.MethodPost, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = transport.RoundTrip(request)
+		Expect(err).To(HaveOccurred())
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Status).To(BeZero())
+		Expect(records[0].Error).To(Equal("connection refused"))
+	})
+
+	It("Uses the subject when there is no user name", func() {
+		header := "Bearer " + makeToken(jwt.MapClaims{"sub": "my-subject"})
+		Expect(tokenUser(header)).To(Equal("my-subject"))
+		Expect(tokenUser("Bearer my-opaque-token")).To(BeEmpty())
+		Expect(tokenUser("")).To(BeEmpty())
+	})
+
+	It("Creates the file only readable by the user", func() {
+		_, err := Wrapper(file, "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		info, err := os.Stat(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("Reads a file that doesn't exist as empty", func() {
+		records, err := Read(file, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	It("Filters records", func() {
+		now := time.Now().UTC()
+		Expect(os.MkdirAll(filepath.Dir(file), 0700)).To(Succeed())
+		Expect(os.WriteFile(file, []byte(
+			`{"time":"`+now.Add(-48*time.Hour).Format(time.RFC3339)+`","user":"alice",`+
+				`"command":"ocm delete cluster 123","method":"DELETE",`+
+				`"path":"/api/clusters_mgmt/v1/clusters/123","status":204}`+"\n"+
+				`{"time":"`+now.Add(-time.Hour).Format(time.RFC3339)+`","user":"bob",`+
+				`"command":"ocm patch ...","method":"PATCH",`+
+				`"path":"/api/clusters_mgmt/v1/clusters/456","status":200}`+"\n"+
+				`{"time":"`+now.Format(time.RFC3339)+`","user":"alice",`+
+				`"command":"ocm post ...","method":"POST",`+
+				`"path":"/api/accounts_mgmt/v1/subscriptions","status":201}`+"\n",
+		), 0600)).To(Succeed())
+
+		records, err := Read(file, &Query{User: "alice"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+
+		records, err = Read(file, &Query{Since: now.Add(-24 * time.Hour)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].User).To(Equal("bob"))
+
+		records, err = Read(file, &Query{Method: "delete"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Path).To(Equal("/api/clusters_mgmt/v1/clusters/123"))
+
+		records, err = Read(file, &Query{Path: "/api/clusters_mgmt/"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+	})
+
+	DescribeTable(
+		"Command line",
+		func(args []string, expected string) {
+			Expect(CommandLine(args)).To(Equal(expected))
+		},
+		Entry(
+			"Simple",
+			[]string{"ocm", "hibernate", "cluster", "123"},
+			"ocm hibernate cluster 123",
+		),
+		Entry(
+			"Quotes spaces",
+			[]string{"ocm", "post", "/api/my_objects", "name=my object"},
+			"ocm post /api/my_objects 'name=my object'",
+		),
+		Entry(
+			"Redacts separate value",
+			[]string{"ocm", "login", "--token", "my-token"},
+			"ocm login --token REDACTED",
+		),
+		Entry(
+			"Redacts inline value",
+			[]string{"ocm", "login", "--client-secret=my-secret"},
+			"ocm login --client-secret=REDACTED",
+		),
+	)
+
+	DescribeTable(
+		"Parse since",
+		func(text string, expected time.Time) {
+			now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+			actual, err := ParseSince(text, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(BeTemporally("==", expected))
+		},
+		Entry("Hours", "24h", time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)),
+		Entry("Days", "7d", time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)),
+		Entry("Date", "2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("Time", "2026-03-01T08:30:00Z", time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)),
+	)
+
+	It("Rejects invalid since", func() {
+		_, err := ParseSince("yesterday", time.Now())
+		Expect(err).To(MatchError(ContainSubstring("time 'yesterday' isn't valid")))
+	})
+})
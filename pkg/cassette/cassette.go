@@ -0,0 +1,533 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cassette contains the transports that record the HTTP traffic of the CLI to a file, and
+// that replay it later without sending anything to the network. The file, known as a cassette,
+// contains one JSON object per line, with the request and the response of each exchange. The
+// credentials are redacted before writing them.
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/roundtrip"
+)
+
+// AddFlags adds the '--record' and '--replay' flags to the given set of command line flags.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&recordFile,
+		"record",
+		"",
+		"Write the HTTP requests and responses to the given file, with the credentials "+
+			"redacted, so that they can be attached to bug reports or replayed later.",
+	)
+	flags.StringVar(
+		&replayFile,
+		"replay",
+		"",
+		"Answer the HTTP requests with the responses stored in the given file, created "+
+			"with '--record', instead of sending them to the network.",
+	)
+}
+
+// RecordFile returns the name of the file where the traffic should be recorded, or an empty
+// string if recording isn't enabled.
+func RecordFile() string {
+	return recordFile
+}
+
+// ReplayFile returns the name of the file that contains the traffic that should be replayed, or
+// an empty string if replaying isn't enabled.
+func ReplayFile() string {
+	return replayFile
+}
+
+var (
+	recordFile string
+	replayFile string
+)
+
+// Interaction is an exchange stored in a cassette.
+type Interaction struct {
+	Time     time.Time `json:"time"`
+	Duration string    `json:"duration"`
+	Request  Request   `json:"request"`
+	Response Response  `json:"response"`
+}
+
+// Request is a request stored in a cassette.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a response stored in a cassette.
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// secretHeaders are the headers whose values are redacted.
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// secretFields are the names of the fields of JSON bodies and of the query parameters whose values
+// are redacted. The 'auth' field contains the credentials of the pull secrets, and 'kubeconfig'
+// contains the private key of the administrator of clusters.
+var secretFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"client_secret": true,
+	"password":      true,
+	"token":         true,
+	"auth":          true,
+	"kubeconfig":    true,
+}
+
+// secretSuffixes are the suffixes of the names of fields and query parameters whose values are
+// redacted, for example 'aws_secret' or 'api_key'.
+var secretSuffixes = []string{
+	"_secret",
+	"_key",
+	"_password",
+	"_token",
+}
+
+// recorders contains the recorders that have already been created, indexed by file name, so that
+// commands that create multiple connections write all the exchanges to the same file.
+var (
+	recorders     = map[string]*recorder{}
+	recordersLock = &sync.Mutex{}
+)
+
+// recorder writes the exchanges to a file. It is shared by all the transports that record to
+// the same file.
+type recorder struct {
+	lock    *sync.Mutex
+	encoder *json.Encoder
+}
+
+// Recorder returns a transport wrapper that sends the requests and writes them, together with the
+// responses, to the given file. The file is truncated the first time that it is used by the
+// process. Requests for the given token URL are sent but not recorded, as they contain
+// credentials.
+func Recorder(file, tokenURL string) (result func(http.RoundTripper) http.RoundTripper, err error) {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+	shared, ok := recorders[file]
+	if !ok {
+		var writer *os.File
+		writer, err = os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600) // #nosec G304
+		if err != nil {
+			err = fmt.Errorf("can't create cassette file: %w", err)
+			return
+		}
+		shared = &recorder{
+			lock:    &sync.Mutex{},
+			encoder: json.NewEncoder(writer),
+		}
+		recorders[file] = shared
+	}
+	result = func(wrapped http.RoundTripper) http.RoundTripper {
+		return &recordTransport{
+			wrapped:  wrapped,
+			recorder: shared,
+			tokenURL: tokenURL,
+		}
+	}
+	return
+}
+
+// recordTransport is the round tripper returned by the recorder wrapper.
+type recordTransport struct {
+	wrapped  http.RoundTripper
+	recorder *recorder
+	tokenURL string
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *recordTransport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	if roundtrip.IsTokenRequest(request, t.tokenURL) {
+		return t.wrapped.RoundTrip(request)
+	}
+	requestBody, err := readBody(&request.Body)
+	if err != nil {
+		return
+	}
+	start := time.Now()
+	response, err = t.wrapped.RoundTrip(request)
+	if err != nil {
+		return
+	}
+	responseBody, err := readBody(&response.Body)
+	if err != nil {
+		return
+	}
+	interaction := &Interaction{
+		Time:     start.UTC(),
+		Duration: time.Since(start).Round(time.Millisecond).String(),
+		Request: Request{
+			Method: request.Method,
+			URL:    redactURL(request.URL).String(),
+			Header: redactHeader(request.Header),
+			Body:   redactBody(requestBody),
+		},
+		Response: Response{
+			Status: response.StatusCode,
+			Header: redactHeader(response.Header),
+			Body:   redactBody(responseBody),
+		},
+	}
+	t.recorder.lock.Lock()
+	defer t.recorder.lock.Unlock()
+	err = t.recorder.encoder.Encode(interaction)
+	if err != nil {
+		err = fmt.Errorf("can't write cassette interaction: %w", err)
+	}
+	return
+}
+
+// Replayer returns a transport wrapper that answers the requests with the responses stored in
+// the given file, without sending anything to the network. Requests are matched by method, path
+// and query, ignoring the scheme and host, so that cassettes can be replayed against a different
+// server. Each interaction is used once, in the recorded order. When all the matching interactions
+// have been used the last one is repeated, so that commands that poll the server can still be
+// replayed. Requests for the given token URL are answered with generated tokens.
+func Replayer(file, tokenURL string) (result func(http.RoundTripper) http.RoundTripper, err error) {
+	interactions, err := Load(file)
+	if err != nil {
+		return
+	}
+	shared := &player{
+		lock:         &sync.Mutex{},
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}
+	result = func(http.RoundTripper) http.RoundTripper {
+		return &replayTransport{
+			player:   shared,
+			tokenURL: tokenURL,
+		}
+	}
+	return
+}
+
+// Load reads the interactions of the given cassette file.
+func Load(file string) (result []*Interaction, err error) {
+	reader, err := os.Open(file) // #nosec G304
+	if err != nil {
+		err = fmt.Errorf("can't open cassette file: %w", err)
+		return
+	}
+	defer reader.Close()
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		interaction := &Interaction{}
+		err = json.Unmarshal(data, interaction)
+		if err != nil {
+			err = fmt.Errorf("can't parse line %d of cassette file '%s': %w", line, file, err)
+			return
+		}
+		result = append(result, interaction)
+	}
+	err = scanner.Err()
+	return
+}
+
+// player contains the state of the replay, shared by all the transports that replay the same
+// cassette.
+type player struct {
+	lock         *sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// replayTransport is the round tripper returned by the replayer wrapper.
+type replayTransport struct {
+	player   *player
+	tokenURL string
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *replayTransport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	_, err = readBody(&request.Body)
+	if err != nil {
+		return
+	}
+	if roundtrip.IsTokenRequest(request, t.tokenURL) {
+		return tokenResponse(request)
+	}
+	interaction := t.player.next(request.Method, redactURL(request.URL).RequestURI())
+	if interaction == nil {
+		err = fmt.Errorf(
+			"cassette doesn't contain a response for '%s %s'",
+			request.Method, request.URL.RequestURI(),
+		)
+		return
+	}
+	status := interaction.Response.Status
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	response = &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       request,
+	}
+	return
+}
+
+// next returns the first interaction not used yet that matches the given method and URI, or the
+// last one that matches if all of them have already been used.
+func (p *player) next(method, uri string) *Interaction {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var last *Interaction
+	for i, interaction := range p.interactions {
+		if interaction.Request.Method != method || requestURI(interaction.Request.URL) != uri {
+			continue
+		}
+		if !p.used[i] {
+			p.used[i] = true
+			return interaction
+		}
+		last = interaction
+	}
+	return last
+}
+
+// requestURI returns the path and query of the given URL.
+func requestURI(text string) string {
+	request, err := http.NewRequest(http.MethodGet, text, nil)
+	if err != nil {
+		return text
+	}
+	return request.URL.RequestURI()
+}
+
+// tokenResponse generates a response for a request to the token URL. The tokens are unsigned,
+// which is enough for the SDK as it doesn't verify the signatures.
+func tokenResponse(request *http.Request) (response *http.Response, err error) {
+	now := time.Now()
+	accessToken, err := generateToken("Bearer", now, now.Add(time.Hour))
+	if err != nil {
+		return
+	}
+	refreshToken, err := generateToken("Refresh", now, now.Add(10*time.Hour))
+	if err != nil {
+		return
+	}
+	body, err := json.Marshal(map[string]any{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "bearer",
+		"expires_in":    3600,
+	})
+	if err != nil {
+		return
+	}
+	response = &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+	return
+}
+
+// generateToken generates an unsigned token of the given type.
+func generateToken(typ string, issued, expires time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"typ": typ,
+		"iat": issued.Unix(),
+		"exp": expires.Unix(),
+	})
+	return token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+}
+
+// readBody reads the given body completely and replaces it with a new reader that returns the same
+// data, so that it can still be used.
+func readBody(body *io.ReadCloser) (result []byte, err error) {
+	if *body == nil || *body == http.NoBody {
+		return
+	}
+	result, err = io.ReadAll(*body)
+	if err != nil {
+		return
+	}
+	err = (*body).Close()
+	if err != nil {
+		return
+	}
+	*body = io.NopCloser(bytes.NewReader(result))
+	return
+}
+
+// redactHeader returns a copy of the given header where the values that contain credentials are
+// redacted, preserving the authentication scheme, for example 'Bearer'.
+func redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	result := header.Clone()
+	for name, values := range result {
+		if !secretHeaders[http.CanonicalHeaderKey(name)] {
+			continue
+		}
+		for i, value := range values {
+			scheme, _, found := strings.Cut(value, " ")
+			if found && name != "Cookie" && name != "Set-Cookie" {
+				values[i] = scheme + " " + config.Redacted
+			} else {
+				values[i] = config.Redacted
+			}
+		}
+	}
+	return result
+}
+
+// redactURL returns a copy of the given URL with the values of the query parameters that contain
+// credentials redacted. The query is only rewritten if it contains any of those parameters, so
+// that the rest of the URLs are recorded exactly as they were sent.
+func redactURL(value *url.URL) *url.URL {
+	result := *value
+	query := result.Query()
+	changed := false
+	for name, values := range query {
+		if !isSecretField(name) {
+			continue
+		}
+		for i := range values {
+			values[i] = config.Redacted
+		}
+		changed = true
+	}
+	if changed {
+		result.RawQuery = query.Encode()
+	}
+	return &result
+}
+
+// isSecretField checks if the given name of a JSON field or query parameter is one of those whose
+// values contain credentials.
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if secretFields[name] {
+		return true
+	}
+	for _, suffix := range secretSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns the text of the given body with the values of the fields that contain
+// credentials redacted, as well as any other string that looks like a token. Bodies that aren't
+// JSON are returned unchanged.
+func redactBody(body []byte) string {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&value)
+	if err != nil {
+		return string(body)
+	}
+	redacted, changed := redactValue(value)
+	if !changed {
+		return string(body)
+	}
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+// redactValue redacts the given JSON value, returning the result and a flag indicating if
+// anything was changed.
+func redactValue(value any) (result any, changed bool) {
+	switch typed := value.(type) {
+	case map[string]any:
+		for name, field := range typed {
+			if _, ok := field.(string); ok && isSecretField(name) {
+				typed[name] = config.Redacted
+				changed = true
+				continue
+			}
+			var fieldChanged bool
+			typed[name], fieldChanged = redactValue(field)
+			changed = changed || fieldChanged
+		}
+	case []any:
+		for i, item := range typed {
+			var itemChanged bool
+			typed[i], itemChanged = redactValue(item)
+			changed = changed || itemChanged
+		}
+	case string:
+		if config.IsJWTToken(typed) || config.IsEncryptedToken(typed) {
+			return config.Redacted, true
+		}
+	}
+	result = value
+	return
+}
@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/clusterspec"
+)
+
+// Plan contains the differences between a cluster specification and the live cluster.
+type Plan struct {
+	Cluster *cmv1.Cluster
+	Desired *clusterspec.Spec
+	Changes []*clusterspec.Change
+}
+
+// LoadPlan loads the specification from the given file and compares it with the cluster that has
+// the given name, identifier or external identifier. If the key is empty the name of the
+// specification is used.
+func LoadPlan(connection *sdk.Connection, file, key string) (*Plan, error) {
+	desired, err := clusterspec.Load(file, os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		key = desired.Name
+	}
+	if key == "" {
+		return nil, fmt.Errorf(
+			"Expected a cluster name, identifier or external identifier, either as argument " +
+				"or in the 'name' field of the specification",
+		)
+	}
+	return NewPlan(connection, key, desired)
+}
+
+// NewPlan retrieves the cluster with the given name, identifier or external identifier, and
+// compares it with the desired specification.
+func NewPlan(connection *sdk.Connection, key string, desired *clusterspec.Spec) (*Plan, error) {
+	desired, err := resolveSpec(desired)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := GetCluster(connection, key)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{
+		Cluster: cluster,
+		Desired: desired,
+		Changes: clusterspec.Diff(CurrentSpec(cluster), desired),
+	}, nil
+}
+
+// resolveSpec returns a copy of the desired specification prepared to be compared with the live
+// cluster. The relative expiration is rejected, because it would be different every time that it
+// is calculated, and the absolute expiration is converted to UTC. Setting only the number of
+// compute nodes disables autoscaling, and setting only the number of replicas enables it, so that
+// the plan describes what will be applied.
+func resolveSpec(desired *clusterspec.Spec) (*clusterspec.Spec, error) {
+	if desired.Expiration != "" {
+		return nil, fmt.Errorf(
+			"The relative 'expiration' field can't be used to plan or apply changes to an " +
+				"existing cluster, use 'expiration_time' instead",
+		)
+	}
+	resolved := *desired
+	if resolved.ExpirationTime != "" {
+		expiration, err := ValidateClusterExpiration(resolved.ExpirationTime, 0)
+		if err != nil {
+			return nil, err
+		}
+		resolved.ExpirationTime = expiration.UTC().Format(time.RFC3339)
+	}
+	if resolved.Autoscaling.Enabled == nil {
+		switch {
+		case resolved.Compute.Nodes != nil:
+			resolved.Autoscaling.Enabled = boolPtr(false)
+		case resolved.Autoscaling.MinReplicas != nil || resolved.Autoscaling.MaxReplicas != nil:
+			resolved.Autoscaling.Enabled = boolPtr(true)
+		}
+	}
+	return &resolved, nil
+}
+
+// CurrentSpec creates a specification that contains the values of the fields of the given
+// cluster that are compared by plans.
+func CurrentSpec(cluster *cmv1.Cluster) *clusterspec.Spec {
+	spec := clusterspec.New()
+	spec.Name = cluster.Name()
+	spec.DomainPrefix = cluster.DomainPrefix()
+	spec.Provider = cluster.CloudProvider().ID()
+	spec.Region = cluster.Region().ID()
+	spec.Flavour = cluster.Flavour().ID()
+	spec.Channel = cluster.Channel()
+	spec.ChannelGroup = cluster.Version().ChannelGroup()
+	spec.MultiAZ = boolPtr(cluster.MultiAZ())
+	spec.Private = boolPtr(cluster.API().Listening() == cmv1.ListeningMethodInternal)
+	spec.Fips = boolPtr(cluster.FIPS())
+	spec.EtcdEncryption = boolPtr(cluster.EtcdEncryption())
+	spec.DeleteProtection = boolPtr(cluster.DeleteProtection().Enabled())
+	if expiration, ok := cluster.GetExpirationTimestamp(); ok {
+		spec.ExpirationTime = expiration.UTC().Format(time.RFC3339)
+	}
+	spec.CCS.Enabled = boolPtr(cluster.CCS().Enabled())
+	spec.Compute.MachineType = cluster.Nodes().ComputeMachineType().ID()
+	autoscaling, ok := cluster.Nodes().GetAutoscaleCompute()
+	spec.Autoscaling.Enabled = boolPtr(ok)
+	if ok {
+		spec.Autoscaling.MinReplicas = intPtr(autoscaling.MinReplicas())
+		spec.Autoscaling.MaxReplicas = intPtr(autoscaling.MaxReplicas())
+	} else if nodes, ok := cluster.Nodes().GetCompute(); ok {
+		spec.Compute.Nodes = intPtr(nodes)
+	}
+	spec.Network.Type = cluster.Network().Type()
+	spec.Network.MachineCIDR = cluster.Network().MachineCIDR()
+	spec.Network.ServiceCIDR = cluster.Network().ServiceCIDR()
+	spec.Network.PodCIDR = cluster.Network().PodCIDR()
+	if hostPrefix, ok := cluster.Network().GetHostPrefix(); ok {
+		spec.Network.HostPrefix = intPtr(hostPrefix)
+	}
+	spec.ClusterWideProxy.HTTPProxy = cluster.Proxy().HTTPProxy()
+	spec.ClusterWideProxy.HTTPSProxy = cluster.Proxy().HTTPSProxy()
+	spec.ClusterWideProxy.NoProxy = cluster.Proxy().NoProxy()
+	return spec
+}
+
+// Apply applies the changes of the plan to the cluster. It fails without changing anything if any
+// of the changes is to a field that can't be changed after the cluster is created.
+func (p *Plan) Apply(client *cmv1.ClustersClient) error {
+	immutable := clusterspec.Immutable(p.Changes)
+	if len(immutable) > 0 {
+		fields := make([]string, len(immutable))
+		for i, change := range immutable {
+			fields[i] = "'" + change.Field + "'"
+		}
+		return fmt.Errorf(
+			"Can't apply the specification because %s can't be changed after the cluster is "+
+				"created, update the specification or create a new cluster",
+			strings.Join(fields, ", "),
+		)
+	}
+
+	// Fields that are changed with the cluster update:
+	config := Spec{}
+	updated := false
+	err := p.applyCompute(&config, &updated)
+	if err != nil {
+		return err
+	}
+	if p.changed("private") {
+		config.Private = p.Desired.Private
+		updated = true
+	}
+	if p.changed("channel") {
+		config.Channel = p.Desired.Channel
+		updated = true
+	}
+	if p.changed("channel_group") {
+		config.ChannelGroup = p.Desired.ChannelGroup
+		updated = true
+	}
+	if p.changed("proxy.http_proxy") {
+		config.ClusterWideProxy.HTTPProxy = &p.Desired.ClusterWideProxy.HTTPProxy
+		updated = true
+	}
+	if p.changed("proxy.https_proxy") {
+		config.ClusterWideProxy.HTTPSProxy = &p.Desired.ClusterWideProxy.HTTPSProxy
+		updated = true
+	}
+	if p.changed("proxy.no_proxy") {
+		config.ClusterWideProxy.NoProxy = &p.Desired.ClusterWideProxy.NoProxy
+		updated = true
+	}
+	if p.changed("expiration_time") {
+		config.Expiration, err = parseRFC3339(p.Desired.ExpirationTime)
+		if err != nil {
+			return fmt.Errorf("Failed to parse expiration_time: %v", err)
+		}
+		updated = true
+	}
+	if updated {
+		err = UpdateCluster(client, p.Cluster.ID(), config)
+		if err != nil {
+			return fmt.Errorf("Failed to update cluster: %v", err)
+		}
+	}
+
+	// Delete protection has its own endpoint:
+	if p.changed("delete_protection") {
+		err = UpdateDeleteProtection(client, p.Cluster.ID(), *p.Desired.DeleteProtection)
+		if err != nil {
+			return fmt.Errorf("Failed to update delete protection: %v", err)
+		}
+	}
+	return nil
+}
+
+// applyCompute sets the compute nodes or the autoscaling settings of the configuration if any of
+// them changed. The values that aren't in the specification are taken from the cluster, because
+// the nodes are updated as a whole.
+func (p *Plan) applyCompute(config *Spec, updated *bool) error {
+	if !p.changed("compute.nodes") && !p.changed("autoscaling.enabled") &&
+		!p.changed("autoscaling.min_replicas") && !p.changed("autoscaling.max_replicas") {
+		return nil
+	}
+	current, autoscaling := p.Cluster.Nodes().GetAutoscaleCompute()
+	if p.Desired.Autoscaling.Enabled != nil {
+		autoscaling = *p.Desired.Autoscaling.Enabled
+	}
+	if autoscaling {
+		config.Autoscaling.Enabled = true
+		config.Autoscaling.MinReplicas = current.MinReplicas()
+		if p.Desired.Autoscaling.MinReplicas != nil {
+			config.Autoscaling.MinReplicas = *p.Desired.Autoscaling.MinReplicas
+		}
+		config.Autoscaling.MaxReplicas = current.MaxReplicas()
+		if p.Desired.Autoscaling.MaxReplicas != nil {
+			config.Autoscaling.MaxReplicas = *p.Desired.Autoscaling.MaxReplicas
+		}
+	} else {
+		config.ComputeNodes = p.Cluster.Nodes().Compute()
+		if p.Desired.Compute.Nodes != nil {
+			config.ComputeNodes = *p.Desired.Compute.Nodes
+		}
+		if config.ComputeNodes == 0 {
+			return fmt.Errorf("The 'compute.nodes' field is required to disable autoscaling")
+		}
+	}
+	*updated = true
+	return nil
+}
+
+// changed checks if the given field of the specification changed.
+func (p *Plan) changed(field string) bool {
+	return clusterspec.Changed(p.Changes, field) != nil
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/clusterspec"
+)
+
+func TestResolveSpecRejectsRelativeExpiration(t *testing.T) {
+	desired := clusterspec.New()
+	desired.Expiration = "24h"
+	_, err := resolveSpec(desired)
+	if err == nil {
+		t.Fatalf("Expected an error for the relative expiration")
+	}
+}
+
+func TestPlanComputeNodesDisablesAutoscaling(t *testing.T) {
+	cluster, err := cmv1.NewCluster().
+		ID("123").
+		Nodes(cmv1.NewClusterNodes().
+			AutoscaleCompute(cmv1.NewMachinePoolAutoscaling().
+				MinReplicas(2).
+				MaxReplicas(6))).
+		Build()
+	if err != nil {
+		t.Fatalf("Can't build cluster: %v", err)
+	}
+	desired := clusterspec.New()
+	desired.Compute.Nodes = intPtr(3)
+	desired, err = resolveSpec(desired)
+	if err != nil {
+		t.Fatalf("Can't resolve specification: %v", err)
+	}
+	plan := &Plan{
+		Cluster: cluster,
+		Desired: desired,
+		Changes: clusterspec.Diff(CurrentSpec(cluster), desired),
+	}
+
+	// The plan should say that autoscaling will be disabled:
+	change := clusterspec.Changed(plan.Changes, "autoscaling.enabled")
+	if change == nil || change.Current != "true" || change.Desired != "false" {
+		t.Errorf("Expected autoscaling to change from 'true' to 'false', got %+v", change)
+	}
+	if clusterspec.Changed(plan.Changes, "compute.nodes") == nil {
+		t.Errorf("Expected a change of 'compute.nodes'")
+	}
+
+	// Applying the plan should set the nodes instead of keeping autoscaling:
+	config := Spec{}
+	updated := false
+	err = plan.applyCompute(&config, &updated)
+	if err != nil {
+		t.Fatalf("Can't apply compute changes: %v", err)
+	}
+	if !updated || config.Autoscaling.Enabled || config.ComputeNodes != 3 {
+		t.Errorf(
+			"Expected 3 nodes without autoscaling, got updated=%t autoscaling=%t nodes=%d",
+			updated, config.Autoscaling.Enabled, config.ComputeNodes,
+		)
+	}
+}
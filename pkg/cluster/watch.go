@@ -0,0 +1,333 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// WatchTarget is the condition that a watcher waits for.
+type WatchTarget string
+
+const (
+	// WatchReady waits till the cluster is ready, for example after creating or resuming it.
+	WatchReady WatchTarget = "ready"
+
+	// WatchHibernating waits till the cluster is hibernating.
+	WatchHibernating WatchTarget = "hibernating"
+
+	// WatchDeleted waits till the cluster is uninstalled and no longer exists.
+	WatchDeleted WatchTarget = "deleted"
+)
+
+// Exit codes used by the commands when waiting for a cluster doesn't succeed. Other errors, like
+// failures to send requests, use the generic exit code 1.
+const (
+	// ExitCodeFailed indicates that the cluster reached a state from which the target can't be
+	// reached, like 'error'.
+	ExitCodeFailed = 2
+
+	// ExitCodeTimeout indicates that the target wasn't reached before the timeout.
+	ExitCodeTimeout = 3
+)
+
+// WatchError is the error returned by the watcher when the cluster fails or the timeout expires.
+// The ExitCode method returns the exit code that the command should use.
+type WatchError struct {
+	code    int
+	message string
+}
+
+// Error returns the message of the error.
+func (e *WatchError) Error() string {
+	return e.message
+}
+
+// ExitCode returns the exit code that corresponds to the error.
+func (e *WatchError) ExitCode() int {
+	return e.code
+}
+
+// WatcherBuilder contains the data and logic needed to create a watcher.
+type WatcherBuilder struct {
+	client    *cmv1.ClustersClient
+	clusterID string
+	target    WatchTarget
+	interval  time.Duration
+	timeout   time.Duration
+	writer    io.Writer
+	logs      bool
+}
+
+// Watcher polls the state of a cluster till it reaches a target, writing the changes of state
+// and optionally the install or uninstall logs.
+type Watcher struct {
+	client    *cmv1.ClustersClient
+	clusterID string
+	target    WatchTarget
+	interval  time.Duration
+	timeout   time.Duration
+	writer    io.Writer
+	logs      bool
+
+	// Name and state of the cluster, as seen in the last poll:
+	name         string
+	state        cmv1.ClusterState
+	uninstalling bool
+
+	// Number of lines of the logs already written:
+	logLines int
+}
+
+// NewWatcher creates a builder that can then be used to configure and create a watcher.
+func NewWatcher() *WatcherBuilder {
+	return &WatcherBuilder{
+		interval: 30 * time.Second,
+	}
+}
+
+// Client sets the client used to retrieve the cluster. This is mandatory.
+func (b *WatcherBuilder) Client(value *cmv1.ClustersClient) *WatcherBuilder {
+	b.client = value
+	return b
+}
+
+// Cluster sets the identifier of the cluster. This is mandatory.
+func (b *WatcherBuilder) Cluster(value string) *WatcherBuilder {
+	b.clusterID = value
+	return b
+}
+
+// Target sets the condition to wait for. This is mandatory.
+func (b *WatcherBuilder) Target(value WatchTarget) *WatcherBuilder {
+	b.target = value
+	return b
+}
+
+// Interval sets the time between polls. The default is 30 seconds.
+func (b *WatcherBuilder) Interval(value time.Duration) *WatcherBuilder {
+	b.interval = value
+	return b
+}
+
+// Timeout sets the maximum time to wait. The default is zero, which means no limit.
+func (b *WatcherBuilder) Timeout(value time.Duration) *WatcherBuilder {
+	b.timeout = value
+	return b
+}
+
+// Writer sets the writer where the progress and the logs are written. This is mandatory.
+func (b *WatcherBuilder) Writer(value io.Writer) *WatcherBuilder {
+	b.writer = value
+	return b
+}
+
+// Logs indicates if the install or uninstall logs should be written while the cluster is
+// installing or uninstalling.
+func (b *WatcherBuilder) Logs(value bool) *WatcherBuilder {
+	b.logs = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new watcher.
+func (b *WatcherBuilder) Build() (result *Watcher, err error) {
+	// Check parameters:
+	if b.client == nil {
+		err = fmt.Errorf("client is mandatory")
+		return
+	}
+	if b.clusterID == "" {
+		err = fmt.Errorf("cluster identifier is mandatory")
+		return
+	}
+	switch b.target {
+	case WatchReady, WatchHibernating, WatchDeleted:
+	default:
+		err = fmt.Errorf("target '%s' isn't valid", b.target)
+		return
+	}
+	if b.writer == nil {
+		err = fmt.Errorf("writer is mandatory")
+		return
+	}
+	if b.interval <= 0 {
+		err = fmt.Errorf("interval should be positive, but it is %s", b.interval)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Watcher{
+		client:    b.client,
+		clusterID: b.clusterID,
+		target:    b.target,
+		interval:  b.interval,
+		timeout:   b.timeout,
+		writer:    b.writer,
+		logs:      b.logs,
+		name:      b.clusterID,
+	}
+	return
+}
+
+// Run polls the cluster till it reaches the target. It returns a *WatchError if the cluster
+// reaches a state from which the target can't be reached, or if the timeout expires.
+func (w *Watcher) Run(ctx context.Context) error {
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	fmt.Fprintf(w.writer, "Waiting for cluster '%s' to be %s\n", w.clusterID, w.target)
+	for {
+		done, err := w.poll(ctx, time.Since(start))
+		if done || err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = w.timeoutError()
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return w.timeoutError()
+			}
+			return ctx.Err()
+		case <-time.After(w.interval):
+		}
+	}
+}
+
+// poll retrieves the cluster once, writes the change of state and the new lines of the logs, if
+// any, and checks if the target has been reached.
+func (w *Watcher) poll(ctx context.Context, elapsed time.Duration) (done bool, err error) {
+	response, err := w.client.Cluster(w.clusterID).Get().SendContext(ctx)
+	if response != nil && response.Status() == http.StatusNotFound {
+		done, failed := checkState(w.target, "", true, w.uninstalling)
+		if failed {
+			err = w.failedError("doesn't exist")
+			return
+		}
+		fmt.Fprintf(w.writer, "[%s] Cluster '%s' has been uninstalled\n", formatElapsed(elapsed), w.name)
+		return done, nil
+	}
+	if err != nil {
+		err = fmt.Errorf("Can't retrieve cluster '%s': %w", w.clusterID, err)
+		return
+	}
+	cluster := response.Body()
+	w.name = cluster.Name()
+	state := cluster.State()
+	if state != w.state {
+		fmt.Fprintf(w.writer, "[%s] Cluster '%s' is %s\n", formatElapsed(elapsed), w.name, state)
+		w.state = state
+	}
+	if state == cmv1.ClusterStateUninstalling {
+		w.uninstalling = true
+	}
+	if w.logs {
+		w.writeLogs(ctx, state)
+	}
+	done, failed := checkState(w.target, state, false, w.uninstalling)
+	if failed {
+		message := fmt.Sprintf("is %s", state)
+		if description := cluster.Status().Description(); description != "" {
+			message = fmt.Sprintf("%s: %s", message, description)
+		}
+		err = w.failedError(message)
+	}
+	return
+}
+
+// writeLogs writes the lines of the install or uninstall logs that haven't been written yet.
+// Logs aren't available for all clusters and states, so errors are ignored.
+func (w *Watcher) writeLogs(ctx context.Context, state cmv1.ClusterState) {
+	resource := w.client.Cluster(w.clusterID).Logs()
+	var request *cmv1.LogGetRequest
+	switch state {
+	case cmv1.ClusterStateInstalling:
+		request = resource.Install().Get()
+	case cmv1.ClusterStateUninstalling:
+		request = resource.Uninstall().Get()
+	default:
+		return
+	}
+	response, err := request.Offset(w.logLines).SendContext(ctx)
+	if err != nil {
+		return
+	}
+	content := strings.TrimRight(response.Body().Content(), "\n")
+	if content == "" {
+		return
+	}
+	for _, line := range strings.Split(content, "\n") {
+		fmt.Fprintf(w.writer, "  %s\n", line)
+		w.logLines++
+	}
+}
+
+func (w *Watcher) failedError(message string) error {
+	return &WatchError{
+		code:    ExitCodeFailed,
+		message: fmt.Sprintf("Cluster '%s' %s, it won't be %s", w.name, message, w.target),
+	}
+}
+
+func (w *Watcher) timeoutError() error {
+	return &WatchError{
+		code: ExitCodeTimeout,
+		message: fmt.Sprintf(
+			"Cluster '%s' isn't %s after %s, last state was '%s'",
+			w.name, w.target, w.timeout, w.state,
+		),
+	}
+}
+
+// checkState checks if the given state of the cluster, or the fact that it doesn't exist, means
+// that the target has been reached, or that it can't be reached. The uninstalling flag indicates
+// if the cluster has been seen uninstalling, so that clusters that were already in the error state
+// before being deleted aren't considered failed.
+func checkState(target WatchTarget, state cmv1.ClusterState, gone,
+	uninstalling bool) (done, failed bool) {
+	switch {
+	case target == WatchDeleted:
+		done = gone
+		failed = state == cmv1.ClusterStateError && uninstalling
+	case gone:
+		failed = true
+	case state == cmv1.ClusterStateError || state == cmv1.ClusterStateUninstalling:
+		failed = true
+	case target == WatchReady:
+		done = state == cmv1.ClusterStateReady
+	case target == WatchHibernating:
+		done = state == cmv1.ClusterStateHibernating
+	}
+	return
+}
+
+// formatElapsed formats the elapsed time rounded to seconds, for example '2m30s'.
+func formatElapsed(elapsed time.Duration) string {
+	return elapsed.Round(time.Second).String()
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func TestCheckState(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       WatchTarget
+		state        cmv1.ClusterState
+		gone         bool
+		uninstalling bool
+		done         bool
+		failed       bool
+	}{
+		{name: "Installing", target: WatchReady, state: cmv1.ClusterStateInstalling},
+		{name: "Ready", target: WatchReady, state: cmv1.ClusterStateReady, done: true},
+		{name: "Install error", target: WatchReady, state: cmv1.ClusterStateError, failed: true},
+		{name: "Deleted while installing", target: WatchReady, gone: true, failed: true},
+		{name: "Uninstalling", target: WatchReady, state: cmv1.ClusterStateUninstalling, failed: true},
+		{name: "Resuming", target: WatchReady, state: cmv1.ClusterStateResuming},
+		{name: "Powering down", target: WatchHibernating, state: cmv1.ClusterStatePoweringDown},
+		{name: "Hibernating", target: WatchHibernating, state: cmv1.ClusterStateHibernating, done: true},
+		{name: "Still ready", target: WatchHibernating, state: cmv1.ClusterStateReady},
+		{name: "Deleting", target: WatchDeleted, state: cmv1.ClusterStateUninstalling, uninstalling: true},
+		{name: "Deleted", target: WatchDeleted, gone: true, uninstalling: true, done: true},
+		{name: "Error before deleting", target: WatchDeleted, state: cmv1.ClusterStateError},
+		{
+			name:         "Uninstall error",
+			target:       WatchDeleted,
+			state:        cmv1.ClusterStateError,
+			uninstalling: true,
+			failed:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done, failed := checkState(test.target, test.state, test.gone, test.uninstalling)
+			if done != test.done || failed != test.failed {
+				t.Errorf(
+					"checkState(%q, %q, %v, %v) = %v, %v, want %v, %v",
+					test.target, test.state, test.gone, test.uninstalling,
+					done, failed, test.done, test.failed,
+				)
+			}
+		})
+	}
+}
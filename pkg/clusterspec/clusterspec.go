@@ -0,0 +1,664 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterspec contains the types and functions used to read cluster specification files,
+// that describe in YAML or JSON the cluster that 'ocm create cluster --from-file' creates. Each
+// field of the file corresponds to one of the flags of that command, as indicated by the 'flag'
+// tag, so that the values of the file are processed exactly like the values of the flags.
+package clusterspec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// APIVersion is the version of the format of the files supported by this package.
+	APIVersion = "v1"
+
+	// Kind is the kind of object described by the files.
+	Kind = "Cluster"
+)
+
+// Spec is the content of a cluster specification file. The structure mirrors the cluster.Spec
+// type used to create clusters. The 'flag' tag contains the name of the corresponding flag of the
+// 'ocm create cluster' command, optionally followed by these options:
+//
+//   - joined: the flag expects the items of the list separated by commas.
+//   - path: the value is a file name, relative to the directory of the specification file.
+//
+// The 'secret' tag marks fields that contain credentials, with the name of the environment
+// variable that is suggested to pass them.
+//
+// The 'plan' tag marks the fields that are compared with the live cluster by 'ocm cluster plan',
+// and says if they can be changed after the cluster is created ('mutable') or not ('immutable').
+// The version isn't compared because it changes when the cluster is upgraded. Fields without a
+// flag, like 'delete_protection', are only used by 'ocm cluster apply'.
+type Spec struct {
+	APIVersion string `yaml:"api_version"`
+	Kind       string `yaml:"kind"`
+
+	Name                string `yaml:"name,omitempty"`
+	DomainPrefix        string `yaml:"domain_prefix,omitempty" flag:"domain-prefix" plan:"immutable"`
+	Provider            string `yaml:"provider,omitempty" flag:"provider" plan:"immutable"`
+	Region              string `yaml:"region,omitempty" flag:"region" plan:"immutable"`
+	SubscriptionType    string `yaml:"subscription_type,omitempty" flag:"subscription-type"`
+	MarketplaceGcpTerms *bool  `yaml:"marketplace_gcp_terms,omitempty" flag:"marketplace-gcp-terms"`
+	Flavour             string `yaml:"flavour,omitempty" flag:"flavour" plan:"immutable"`
+	Version             string `yaml:"version,omitempty" flag:"version"`
+	Channel             string `yaml:"channel,omitempty" flag:"channel" plan:"mutable"`
+	ChannelGroup        string `yaml:"channel_group,omitempty" flag:"channel-group" plan:"mutable"`
+	MultiAZ             *bool  `yaml:"multi_az,omitempty" flag:"multi-az" plan:"immutable"`
+	Private             *bool  `yaml:"private,omitempty" flag:"private" plan:"mutable"`
+	Fips                *bool  `yaml:"fips,omitempty" flag:"fips" plan:"immutable"`
+	EtcdEncryption      *bool  `yaml:"etcd_encryption,omitempty" flag:"etcd-encryption" plan:"immutable"`
+	Expiration          string `yaml:"expiration,omitempty" flag:"expiration"`
+	ExpirationTime      string `yaml:"expiration_time,omitempty" flag:"expiration-time" plan:"mutable"`
+	DeleteProtection    *bool  `yaml:"delete_protection,omitempty" plan:"mutable"`
+
+	CCS                      CCS                      `yaml:"ccs,omitempty"`
+	GcpAuthentication        GcpAuthentication        `yaml:"gcp_authentication,omitempty"`
+	ExistingVPC              ExistingVPC              `yaml:"existing_vpc,omitempty"`
+	ClusterWideProxy         ClusterWideProxy         `yaml:"proxy,omitempty"`
+	Compute                  Compute                  `yaml:"compute,omitempty"`
+	Autoscaling              Autoscaling              `yaml:"autoscaling,omitempty"`
+	Network                  Network                  `yaml:"network,omitempty"`
+	DefaultIngress           DefaultIngress           `yaml:"default_ingress,omitempty"`
+	GcpSecurity              GcpSecurity              `yaml:"gcp_security,omitempty"`
+	GcpPrivateServiceConnect GcpPrivateServiceConnect `yaml:"gcp_private_service_connect,omitempty"`
+	GcpEncryption            GcpEncryption            `yaml:"gcp_encryption,omitempty"`
+	DNS                      DNS                      `yaml:"dns,omitempty"`
+}
+
+// CCS contains the settings of clusters that use the cloud account of the customer.
+type CCS struct {
+	Enabled *bool          `yaml:"enabled,omitempty" flag:"ccs" plan:"immutable"`
+	AWS     AWSCredentials `yaml:"aws,omitempty"`
+}
+
+// AWSCredentials contains the credentials of the AWS account of CCS clusters.
+// nolint:lll
+type AWSCredentials struct {
+	AccountID       string `yaml:"account_id,omitempty" flag:"aws-account-id"`
+	AccessKeyID     string `yaml:"access_key_id,omitempty" flag:"aws-access-key-id" secret:"AWS_ACCESS_KEY_ID"`
+	SecretAccessKey string `yaml:"secret_access_key,omitempty" flag:"aws-secret-access-key" secret:"AWS_SECRET_ACCESS_KEY"`
+}
+
+// GcpAuthentication contains the settings used to authenticate to GCP. Only one of the fields
+// can be used.
+// nolint:lll
+type GcpAuthentication struct {
+	WifConfig          string `yaml:"wif_config,omitempty" flag:"wif-config"`
+	ServiceAccountFile string `yaml:"service_account_file,omitempty" flag:"service-account-file,path" secret:"GCP_SERVICE_ACCOUNT_FILE"`
+}
+
+// ExistingVPC contains the settings of clusters installed in an existing VPC.
+// nolint:lll
+type ExistingVPC struct {
+	SubnetIDs                              []string `yaml:"subnet_ids,omitempty" flag:"subnet-ids,joined"`
+	AvailabilityZones                      []string `yaml:"availability_zones,omitempty" flag:"availability-zones"`
+	VPCName                                string   `yaml:"vpc_name,omitempty" flag:"vpc-name"`
+	VPCProjectID                           string   `yaml:"vpc_project_id,omitempty" flag:"vpc-project-id"`
+	ControlPlaneSubnet                     string   `yaml:"control_plane_subnet,omitempty" flag:"control-plane-subnet"`
+	ComputeSubnet                          string   `yaml:"compute_subnet,omitempty" flag:"compute-subnet"`
+	AdditionalComputeSecurityGroupIds      []string `yaml:"additional_compute_security_group_ids,omitempty" flag:"additional-compute-security-group-ids"`
+	AdditionalInfraSecurityGroupIds        []string `yaml:"additional_infra_security_group_ids,omitempty" flag:"additional-infra-security-group-ids"`
+	AdditionalControlPlaneSecurityGroupIds []string `yaml:"additional_control_plane_security_group_ids,omitempty" flag:"additional-control-plane-security-group-ids"`
+}
+
+// ClusterWideProxy contains the settings of the proxy used by the cluster.
+// nolint:lll
+type ClusterWideProxy struct {
+	HTTPProxy                 string `yaml:"http_proxy,omitempty" flag:"http-proxy" plan:"mutable"`
+	HTTPSProxy                string `yaml:"https_proxy,omitempty" flag:"https-proxy" plan:"mutable"`
+	NoProxy                   string `yaml:"no_proxy,omitempty" flag:"no-proxy" plan:"mutable"`
+	AdditionalTrustBundleFile string `yaml:"additional_trust_bundle_file,omitempty" flag:"additional-trust-bundle-file,path"`
+}
+
+// Compute contains the settings of the compute nodes.
+type Compute struct {
+	MachineType  string `yaml:"machine_type,omitempty" flag:"compute-machine-type" plan:"immutable"`
+	Nodes        *int   `yaml:"nodes,omitempty" flag:"compute-nodes" plan:"mutable"`
+	RootDiskSize *int   `yaml:"root_disk_size,omitempty" flag:"root-disk-size"`
+}
+
+// Autoscaling contains the settings of the autoscaling of the compute nodes.
+type Autoscaling struct {
+	Enabled     *bool `yaml:"enabled,omitempty" flag:"enable-autoscaling" plan:"mutable"`
+	MinReplicas *int  `yaml:"min_replicas,omitempty" flag:"min-replicas" plan:"mutable"`
+	MaxReplicas *int  `yaml:"max_replicas,omitempty" flag:"max-replicas" plan:"mutable"`
+}
+
+// Network contains the network settings.
+type Network struct {
+	Type        string `yaml:"type,omitempty" flag:"network-type" plan:"immutable"`
+	MachineCIDR string `yaml:"machine_cidr,omitempty" flag:"machine-cidr" plan:"immutable"`
+	ServiceCIDR string `yaml:"service_cidr,omitempty" flag:"service-cidr" plan:"immutable"`
+	PodCIDR     string `yaml:"pod_cidr,omitempty" flag:"pod-cidr" plan:"immutable"`
+	HostPrefix  *int   `yaml:"host_prefix,omitempty" flag:"host-prefix" plan:"immutable"`
+}
+
+// DefaultIngress contains the settings of the default ingress.
+// nolint:lll
+type DefaultIngress struct {
+	RouteSelectors             map[string]string   `yaml:"route_selectors,omitempty" flag:"default-ingress-route-selector"`
+	ExcludedNamespaces         []string            `yaml:"excluded_namespaces,omitempty" flag:"default-ingress-excluded-namespaces,joined"`
+	ExcludedNamespaceSelectors map[string][]string `yaml:"excluded_namespace_selectors,omitempty" flag:"default-ingress-excluded-namespace-selectors"`
+	WildcardPolicy             string              `yaml:"wildcard_policy,omitempty" flag:"default-ingress-wildcard-policy"`
+	NamespaceOwnershipPolicy   string              `yaml:"namespace_ownership_policy,omitempty" flag:"default-ingress-namespace-ownership-policy"`
+}
+
+// GcpSecurity contains the security settings of GCP clusters.
+type GcpSecurity struct {
+	SecureBoot *bool `yaml:"secure_boot,omitempty" flag:"secure-boot-for-shielded-vms"`
+}
+
+// GcpPrivateServiceConnect contains the Private Service Connect settings of GCP clusters.
+type GcpPrivateServiceConnect struct {
+	ServiceAttachmentSubnet string `yaml:"service_attachment_subnet,omitempty" flag:"psc-subnet"`
+}
+
+// GcpEncryption contains the settings of the custom encryption keys of GCP clusters.
+type GcpEncryption struct {
+	KmsKeyLocation       string `yaml:"kms_key_location,omitempty" flag:"kms-key-location"`
+	KmsKeyRing           string `yaml:"kms_key_ring,omitempty" flag:"kms-key-ring"`
+	KmsKeyName           string `yaml:"kms_key_name,omitempty" flag:"kms-key-name"`
+	KmsKeyServiceAccount string `yaml:"kms_key_service_account,omitempty" flag:"kms-key-service-account"`
+}
+
+// DNS contains the DNS settings.
+type DNS struct {
+	ZoneID string `yaml:"zone_id,omitempty" flag:"dns-zone-id"`
+}
+
+// Flag is the value of one of the flags of the 'ocm create cluster' command extracted from a
+// specification. List flags have one value per item, so the flag should be set once per value.
+type Flag struct {
+	Name   string
+	Values []string
+
+	// Secret is the name of the environment variable suggested for the value, if it contains
+	// credentials.
+	Secret string
+}
+
+// Load reads the specification from the given file. References to environment variables, like
+// '${MY_SECRET}', are replaced with the values returned by the lookup function, and relative file
+// names are resolved relative to the directory of the file.
+func Load(file string, lookup func(string) (string, bool)) (result *Spec, err error) {
+	data, err := os.ReadFile(file) // #nosec G304
+	if err != nil {
+		err = fmt.Errorf("can't read cluster specification: %w", err)
+		return
+	}
+	result, err = Parse(data, lookup)
+	if err != nil {
+		err = fmt.Errorf("can't load cluster specification '%s': %w", file, err)
+		return
+	}
+	result.resolvePaths(filepath.Dir(file))
+	return
+}
+
+// Parse parses a specification from the given YAML or JSON document. References to environment
+// variables are replaced with the values returned by the lookup function.
+func Parse(data []byte, lookup func(string) (string, bool)) (result *Spec, err error) {
+	// Replace the references to environment variables in the values before decoding, so that
+	// they can also be used for numbers and booleans:
+	document := &yaml.Node{}
+	err = yaml.Unmarshal(data, document)
+	if err != nil {
+		return
+	}
+	if document.Kind == 0 {
+		err = fmt.Errorf("document is empty")
+		return
+	}
+	err = interpolateNode(document, "", lookup)
+	if err != nil {
+		return
+	}
+	data, err = yaml.Marshal(document)
+	if err != nil {
+		return
+	}
+
+	// Decode rejecting unknown fields, as they are most likely typos:
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	result = &Spec{}
+	err = decoder.Decode(result)
+	if err != nil {
+		return
+	}
+	if result.APIVersion != APIVersion {
+		err = fmt.Errorf(
+			"unsupported API version '%s', it should be '%s'",
+			result.APIVersion, APIVersion,
+		)
+		return
+	}
+	if result.Kind != Kind {
+		err = fmt.Errorf("unsupported kind '%s', it should be '%s'", result.Kind, Kind)
+		return
+	}
+	return
+}
+
+// interpolateNode replaces the references to environment variables in the scalar values of the
+// given node and its descendants. The path is used to report errors.
+func interpolateNode(node *yaml.Node, path string, lookup func(string) (string, bool)) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		value, err := interpolate(node.Value, lookup)
+		if err != nil {
+			if path != "" {
+				err = fmt.Errorf("%s: %w", path, err)
+			}
+			return err
+		}
+		if value != node.Value {
+			node.Value = value
+
+			// Let the decoder resolve again the type of plain values, so that a reference can
+			// be used for a number or a boolean:
+			if node.Style == 0 {
+				node.Tag = ""
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			child := node.Content[i].Value
+			if path != "" {
+				child = path + "." + child
+			}
+			err := interpolateNode(node.Content[i+1], child, lookup)
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		for i, content := range node.Content {
+			child := path
+			if node.Kind == yaml.SequenceNode {
+				child = fmt.Sprintf("%s[%d]", path, i)
+			}
+			err := interpolateNode(content, child, lookup)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// interpolate replaces the references to environment variables, like '${MY_VAR}', contained in
+// the given text. A dollar sign can be written as '$$'. Variables that aren't set are an error,
+// because silently using an empty value would create a cluster different to the one described.
+func interpolate(text string, lookup func(string) (string, bool)) (result string, err error) {
+	if !strings.Contains(text, "$") {
+		result = text
+		return
+	}
+	buffer := &strings.Builder{}
+	for i := 0; i < len(text); i++ {
+		if text[i] != '$' {
+			buffer.WriteByte(text[i])
+			continue
+		}
+		switch {
+		case strings.HasPrefix(text[i:], "$$"):
+			buffer.WriteByte('$')
+			i++
+		case strings.HasPrefix(text[i:], "${"):
+			end := strings.IndexByte(text[i:], '}')
+			if end == -1 {
+				err = fmt.Errorf("reference to environment variable isn't closed")
+				return
+			}
+			name := text[i+2 : i+end]
+			if name == "" {
+				err = fmt.Errorf("reference to environment variable doesn't contain a name")
+				return
+			}
+			value, ok := lookup(name)
+			if !ok {
+				err = fmt.Errorf("environment variable '%s' isn't set", name)
+				return
+			}
+			buffer.WriteString(value)
+			i += end
+		default:
+			buffer.WriteByte('$')
+		}
+	}
+	result = buffer.String()
+	return
+}
+
+// resolvePaths makes the file names of the specification relative to the given directory.
+func (s *Spec) resolvePaths(dir string) {
+	walkFields(reflect.ValueOf(s).Elem(), func(field reflect.StructField, value reflect.Value) {
+		_, options := parseFlagTag(field)
+		if !options["path"] || value.Kind() != reflect.String {
+			return
+		}
+		path := value.String()
+		if path == "" || filepath.IsAbs(path) || strings.HasPrefix(path, "~") {
+			return
+		}
+		value.SetString(filepath.Join(dir, path))
+	})
+}
+
+// Flags returns the values of the flags of the 'ocm create cluster' command that correspond to
+// the fields of the specification that have values, in the order they are declared.
+func (s *Spec) Flags() (result []*Flag) {
+	walkFields(reflect.ValueOf(s).Elem(), func(field reflect.StructField, value reflect.Value) {
+		name, options := parseFlagTag(field)
+		if name == "" {
+			return
+		}
+		values := flagValues(value, options["joined"])
+		if values == nil {
+			return
+		}
+		result = append(result, &Flag{
+			Name:   name,
+			Values: values,
+			Secret: field.Tag.Get("secret"),
+		})
+	})
+	return
+}
+
+// flagValues converts the value of a field into the text of the values of the corresponding flag.
+// The result is nil if the field doesn't have a value.
+func flagValues(value reflect.Value, joined bool) []string {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		return flagValues(value.Elem(), joined)
+	case reflect.String:
+		if value.String() == "" {
+			return nil
+		}
+		return []string{value.String()}
+	case reflect.Bool:
+		return []string{strconv.FormatBool(value.Bool())}
+	case reflect.Int:
+		return []string{strconv.FormatInt(value.Int(), 10)}
+	case reflect.Slice:
+		if value.Len() == 0 {
+			return nil
+		}
+		items := value.Interface().([]string)
+		if joined {
+			return []string{strings.Join(items, ",")}
+		}
+		return append([]string{}, items...)
+	case reflect.Map:
+		if value.Len() == 0 {
+			return nil
+		}
+		// Maps are converted to comma separated 'key=value' pairs, sorted by key so that the
+		// result is predictable. Keys with multiple values are repeated:
+		keys := make([]string, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		pairs := []string{}
+		for _, key := range keys {
+			item := value.MapIndex(reflect.ValueOf(key))
+			switch item.Kind() {
+			case reflect.Slice:
+				for _, text := range item.Interface().([]string) {
+					pairs = append(pairs, key+"="+text)
+				}
+			default:
+				pairs = append(pairs, key+"="+item.String())
+			}
+		}
+		return []string{strings.Join(pairs, ",")}
+	default:
+		return nil
+	}
+}
+
+// walkFields calls the given function for each of the fields of the given struct value that
+// aren't structs themselves, descending into the nested structs.
+func walkFields(value reflect.Value, visit func(reflect.StructField, reflect.Value)) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			walkFields(value.Field(i), visit)
+			continue
+		}
+		visit(field, value.Field(i))
+	}
+}
+
+// parseFlagTag returns the name of the flag and the options from the 'flag' tag of the given
+// field.
+func parseFlagTag(field reflect.StructField) (name string, options map[string]bool) {
+	parts := strings.Split(field.Tag.Get("flag"), ",")
+	name = parts[0]
+	options = map[string]bool{}
+	for _, option := range parts[1:] {
+		options[option] = true
+	}
+	return
+}
+
+// New creates an empty specification with the current version and kind.
+func New() *Spec {
+	return &Spec{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+	}
+}
+
+// FromFlags creates a specification from the values of the flags of the 'ocm create cluster'
+// command. The lookup function returns the values of the given flag, and false if it doesn't have
+// a value that should be part of the specification. Relative file names are converted to absolute,
+// as they are relative to the current directory and not to the location of the file.
+func FromFlags(lookup func(string) ([]string, bool)) (result *Spec, err error) {
+	result = New()
+	walkFields(reflect.ValueOf(result).Elem(), func(field reflect.StructField, value reflect.Value) {
+		name, options := parseFlagTag(field)
+		if name == "" || err != nil {
+			return
+		}
+		values, ok := lookup(name)
+		if !ok || len(values) == 0 {
+			return
+		}
+		err = setField(value, values, options["joined"])
+		if err != nil {
+			err = fmt.Errorf("invalid value for flag '--%s': %w", name, err)
+			return
+		}
+		if options["path"] && value.String() != "" {
+			var path string
+			path, err = filepath.Abs(value.String())
+			if err == nil {
+				value.SetString(path)
+			}
+		}
+	})
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+// setField sets the value of a field from the text of the values of the corresponding flag. It
+// is the inverse of the flagValues function.
+func setField(value reflect.Value, values []string, joined bool) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		item := reflect.New(value.Type().Elem())
+		err := setField(item.Elem(), values, joined)
+		if err != nil {
+			return err
+		}
+		value.Set(item)
+	case reflect.String:
+		value.SetString(values[0])
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+	case reflect.Int:
+		parsed, err := strconv.Atoi(values[0])
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(parsed))
+	case reflect.Slice:
+		items := values
+		if joined {
+			items = splitList(values[0])
+		}
+		value.Set(reflect.ValueOf(append([]string{}, items...)))
+	case reflect.Map:
+		result := reflect.MakeMap(value.Type())
+		for _, pair := range splitList(values[0]) {
+			key, text, ok := strings.Cut(pair, "=")
+			if !ok || key == "" {
+				return fmt.Errorf("'%s' should be in the 'key=value' format", pair)
+			}
+			key = strings.TrimSpace(key)
+			text = strings.TrimSpace(text)
+			if value.Type().Elem().Kind() == reflect.Slice {
+				current := result.MapIndex(reflect.ValueOf(key))
+				items := []string{}
+				if current.IsValid() {
+					items = current.Interface().([]string)
+				}
+				result.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(append(items, text)))
+			} else {
+				result.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(text))
+			}
+		}
+		value.Set(result)
+	}
+	return nil
+}
+
+// splitList splits a comma separated list, ignoring empty items and surrounding spaces.
+func splitList(text string) []string {
+	result := []string{}
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Redact replaces the values of the fields that contain credentials with references to the
+// environment variables suggested for them, like '${AWS_SECRET_ACCESS_KEY}', so that the
+// specification can be stored and shared safely.
+func (s *Spec) Redact() {
+	walkFields(reflect.ValueOf(s).Elem(), func(field reflect.StructField, value reflect.Value) {
+		secret := field.Tag.Get("secret")
+		if secret == "" || value.Kind() != reflect.String || value.String() == "" {
+			return
+		}
+		value.SetString("${" + secret + "}")
+	})
+}
+
+// Marshal returns the YAML document that contains the specification.
+func (s *Spec) Marshal() ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+	err := encoder.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Command returns the 'ocm create cluster' command line that creates the cluster described by
+// the specification, one flag per line. The values of the fields that contain credentials are
+// replaced with references to the environment variables suggested for them.
+func (s *Spec) Command() string {
+	lines := []string{"ocm create cluster"}
+	for _, flag := range s.Flags() {
+		for _, value := range flag.Values {
+			switch {
+			case flag.Secret != "":
+				lines = append(lines, fmt.Sprintf(`--%s "${%s}"`, flag.Name, flag.Secret))
+			case value == "true" && isBoolFlag(s, flag.Name):
+				lines = append(lines, "--"+flag.Name)
+			case isBoolFlag(s, flag.Name):
+				lines = append(lines, fmt.Sprintf("--%s=%s", flag.Name, value))
+			default:
+				lines = append(lines, fmt.Sprintf("--%s %s", flag.Name, quote(value)))
+			}
+		}
+	}
+	if s.Name != "" {
+		lines = append(lines, quote(s.Name))
+	}
+	return strings.Join(lines, " \\\n  ")
+}
+
+// isBoolFlag checks if the field of the specification that corresponds to the given flag is a
+// boolean.
+func isBoolFlag(s *Spec, name string) (result bool) {
+	walkFields(reflect.ValueOf(s).Elem(), func(field reflect.StructField, value reflect.Value) {
+		flag, _ := parseFlagTag(field)
+		if flag == name {
+			result = field.Type == reflect.TypeOf((*bool)(nil))
+		}
+	})
+	return
+}
+
+// quote quotes the given text so that it can be safely used as a single argument in a POSIX
+// shell. Text that only contains safe characters is returned unchanged, for readability.
+func quote(text string) string {
+	if text != "" && strings.Trim(text, safeChars) == "" {
+		return text
+	}
+	return "'" + strings.ReplaceAll(text, "'", `'\''`) + "'"
+}
+
+// safeChars are the characters that don't need to be quoted in a POSIX shell.
+const safeChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./:=,@%+"
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterspec
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Change is a difference between the value of a field in the live cluster and the value in the
+// specification.
+type Change struct {
+	// Field is the path of the field in the specification, like 'compute.nodes'.
+	Field string
+
+	// Current and Desired are the text of the values, empty if the field doesn't have a value.
+	Current string
+	Desired string
+
+	// Mutable indicates if the field can be changed after the cluster is created.
+	Mutable bool
+}
+
+// Diff compares the fields of the desired specification that have a 'plan' tag with the same
+// fields of the current one, usually created from the live cluster. Fields that don't have a value
+// in the desired specification aren't managed by it, so they are never reported as changed.
+func Diff(current, desired *Spec) (result []*Change) {
+	currentFields := planFields(current)
+	walkPaths(reflect.ValueOf(desired).Elem(), "", func(path string, field reflect.StructField,
+		value reflect.Value) {
+		plan := field.Tag.Get("plan")
+		if plan == "" {
+			return
+		}
+		desiredValue := fieldText(value)
+		if desiredValue == "" || desiredValue == currentFields[path] {
+			return
+		}
+		result = append(result, &Change{
+			Field:   path,
+			Current: currentFields[path],
+			Desired: desiredValue,
+			Mutable: plan == "mutable",
+		})
+	})
+	return
+}
+
+// Immutable returns the changes of the given list that can't be applied to an existing cluster.
+func Immutable(changes []*Change) (result []*Change) {
+	for _, change := range changes {
+		if !change.Mutable {
+			result = append(result, change)
+		}
+	}
+	return
+}
+
+// Changed returns the change of the given field, or nil if it didn't change.
+func Changed(changes []*Change, field string) *Change {
+	for _, change := range changes {
+		if change.Field == field {
+			return change
+		}
+	}
+	return nil
+}
+
+// WriteChanges writes the given changes, one per line. Fields that will be set are marked with a
+// plus sign, fields that will be modified with a tilde, and fields that can't be changed with an
+// exclamation mark.
+func WriteChanges(writer io.Writer, changes []*Change) error {
+	for _, change := range changes {
+		var err error
+		switch {
+		case !change.Mutable:
+			_, err = fmt.Fprintf(writer, "  ! %s: %s -> %s (can't be changed)\n", change.Field,
+				textOrNone(change.Current), change.Desired)
+		case change.Current == "":
+			_, err = fmt.Fprintf(writer, "  + %s: %s\n", change.Field, change.Desired)
+		default:
+			_, err = fmt.Fprintf(writer, "  ~ %s: %s -> %s\n", change.Field, change.Current,
+				change.Desired)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// textOrNone returns the given text, or '(none)' if it is empty.
+func textOrNone(text string) string {
+	if text == "" {
+		return "(none)"
+	}
+	return text
+}
+
+// planFields returns the text of the values of the fields of the specification that have a
+// 'plan' tag, indexed by path.
+func planFields(spec *Spec) map[string]string {
+	result := map[string]string{}
+	walkPaths(reflect.ValueOf(spec).Elem(), "", func(path string, field reflect.StructField,
+		value reflect.Value) {
+		if field.Tag.Get("plan") != "" {
+			result[path] = fieldText(value)
+		}
+	})
+	return result
+}
+
+// fieldText converts the value of a field to text, using the same format than the flags. The
+// result is empty if the field doesn't have a value.
+func fieldText(value reflect.Value) string {
+	return strings.Join(flagValues(value, true), ",")
+}
+
+// walkPaths is like walkFields, but it also passes to the function the path of the field, made of
+// the names of the YAML fields separated by dots.
+func walkPaths(value reflect.Value, prefix string,
+	visit func(string, reflect.StructField, reflect.Value)) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		if field.Type.Kind() == reflect.Struct {
+			walkPaths(value.Field(i), name, visit)
+			continue
+		}
+		visit(name, field, value.Field(i))
+	}
+}
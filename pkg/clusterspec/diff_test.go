@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterspec
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Diff", func() {
+	// parse parses a specification that doesn't reference environment variables.
+	parse := func(text string) *Spec {
+		spec, err := Parse([]byte(text), func(string) (string, bool) {
+			return "", false
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return spec
+	}
+
+	current := `{
+		"api_version": "v1",
+		"kind": "Cluster",
+		"name": "my-cluster",
+		"region": "us-east-1",
+		"private": false,
+		"delete_protection": false,
+		"compute": {"machine_type": "m5.xlarge", "nodes": 3},
+		"autoscaling": {"enabled": false},
+		"proxy": {"http_proxy": "http://proxy.example.com"}
+	}`
+
+	It("Doesn't report changes when the fields match", func() {
+		changes := Diff(parse(current), parse(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"region": "us-east-1",
+			"compute": {"nodes": 3}
+		}`))
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Ignores fields that aren't in the desired specification", func() {
+		changes := Diff(parse(current), parse(`{
+			"api_version": "v1",
+			"kind": "Cluster"
+		}`))
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Ignores fields that aren't compared", func() {
+		changes := Diff(parse(current), parse(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"version": "4.18.1",
+			"ccs": {"aws": {"account_id": "123"}}
+		}`))
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("Reports mutable and immutable changes", func() {
+		changes := Diff(parse(current), parse(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"region": "us-west-2",
+			"private": true,
+			"delete_protection": true,
+			"compute": {"machine_type": "m5.xlarge", "nodes": 6},
+			"proxy": {"https_proxy": "https://proxy.example.com"}
+		}`))
+		Expect(changes).To(Equal([]*Change{
+			{Field: "region", Current: "us-east-1", Desired: "us-west-2", Mutable: false},
+			{Field: "private", Current: "false", Desired: "true", Mutable: true},
+			{Field: "delete_protection", Current: "false", Desired: "true", Mutable: true},
+			{Field: "proxy.https_proxy", Current: "", Desired: "https://proxy.example.com", Mutable: true},
+			{Field: "compute.nodes", Current: "3", Desired: "6", Mutable: true},
+		}))
+		Expect(Immutable(changes)).To(Equal(changes[:1]))
+		Expect(Changed(changes, "compute.nodes")).To(Equal(changes[4]))
+		Expect(Changed(changes, "compute.machine_type")).To(BeNil())
+	})
+
+	It("Writes the changes", func() {
+		buffer := &bytes.Buffer{}
+		err := WriteChanges(buffer, []*Change{
+			{Field: "region", Current: "us-east-1", Desired: "us-west-2"},
+			{Field: "network.host_prefix", Desired: "23"},
+			{Field: "proxy.https_proxy", Desired: "https://proxy.example.com", Mutable: true},
+			{Field: "compute.nodes", Current: "3", Desired: "6", Mutable: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("" +
+			"  ! region: us-east-1 -> us-west-2 (can't be changed)\n" +
+			"  ! network.host_prefix: (none) -> 23 (can't be changed)\n" +
+			"  + proxy.https_proxy: https://proxy.example.com\n" +
+			"  ~ compute.nodes: 3 -> 6\n",
+		))
+	})
+})
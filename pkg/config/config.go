@@ -20,7 +20,6 @@ limitations under the License.
 package config
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -32,41 +31,81 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/properties"
 )
 
-// Config is the type used to store the configuration of the client.
+// Config is the type used to store the configuration of the client. The `json` tag of each field is
+// the name of the setting, the `doc` tag is its description, the `secret` tag indicates that the
+// value shouldn't be displayed by default and the `validate` tag selects additional checks for the
+// value. See the Fields function for details.
 // There's no way to line-split or predefine tags, so...
 // nolint:lll
 type Config struct {
 	// TODO(efried): Better docs for things like AccessToken
 	// TODO(efried): Dedup with flag docs in cmd/ocm/login/cmd.go:init where possible
-	AccessToken  string   `json:"access_token,omitempty" doc:"Bearer access token."`
+	AccessToken  string   `json:"access_token,omitempty" secret:"true" doc:"Bearer access token."`
 	ClientID     string   `json:"client_id,omitempty" doc:"OpenID client identifier."`
-	ClientSecret string   `json:"client_secret,omitempty" doc:"OpenID client secret."`
+	ClientSecret string   `json:"client_secret,omitempty" secret:"true" doc:"OpenID client secret."`
 	Insecure     bool     `json:"insecure,omitempty" doc:"Enables insecure communication with the server. This disables verification of TLS certificates and host names."`
 	OpaqueToken  bool     `json:"opaque_token,omitempty" doc:"Indicates that the access token is opaque (non-JWT) and should be sent as-is without SDK token management."`
-	Password     string   `json:"password,omitempty" doc:"User password."`
-	RefreshToken string   `json:"refresh_token,omitempty" doc:"Offline or refresh token."`
+	Password     string   `json:"password,omitempty" secret:"true" doc:"User password."`
+	RefreshToken string   `json:"refresh_token,omitempty" secret:"true" doc:"Offline or refresh token."`
 	Scopes       []string `json:"scopes,omitempty" doc:"OpenID scope. If this option is used it will replace completely the default scopes. Can be repeated multiple times to specify multiple scopes."`
-	TokenURL     string   `json:"token_url,omitempty" doc:"OpenID token URL."`
-	URL          string   `json:"url,omitempty" doc:"URL of the API gateway. The value can be the complete URL or an alias. The valid aliases are 'production', 'staging' and 'integration'."`
+	TokenURL     string   `json:"token_url,omitempty" validate:"url" doc:"OpenID token URL."`
+	URL          string   `json:"url,omitempty" validate:"url" doc:"URL of the API gateway. The value can be the complete URL or an alias. The valid aliases are 'production', 'staging' and 'integration'."`
 	User         string   `json:"user,omitempty" doc:"User name."`
 	Pager        string   `json:"pager,omitempty" doc:"Pager command, for example 'less'. If empty no pager will be used."`
+	AuditLog     string   `json:"audit_log,omitempty" doc:"File where a record of each request that changes something in the server, like POST, PATCH or DELETE, is appended. If empty no audit log will be written. Use 'ocm audit log' to query it."`
+
+	// Aliases contains the resource aliases defined by the user, for example 'mp' for
+	// '/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}'. They are managed with the
+	// 'ocm alias' commands instead of the 'ocm config' commands.
+	Aliases map[string]string `json:"aliases,omitempty"`
 }
 
-// Load loads the configuration from the OS keyring first if available, load from the configuration file if not
-func Load() (cfg *Config, err error) {
-	if keyring, ok := IsKeyringManaged(); ok {
-		return loadFromOS(keyring)
+// LoadStored loads the configuration of the active profile from the OS keyring first if available,
+// load from the configuration file if not. The result contains only the values that have been saved,
+// without the defaults or the values overridden with environment variables or command line flags.
+// See the Load function for that.
+func LoadStored() (cfg *Config, err error) {
+	profiles, err := LoadProfiles()
+	if err != nil || profiles == nil {
+		return
+	}
+	cfg = profiles.Profiles[profiles.Active()]
+	if cfg == nil {
+		cfg = &Config{}
 	}
+	return
+}
 
-	return loadFromFile()
+// SaveStored saves the given configuration as the configuration of the active profile, preserving
+// the rest of the profiles. All the values are saved, see the Save function for a version that
+// doesn't save the values overridden with environment variables or command line flags.
+func SaveStored(cfg *Config) error {
+	// Note that if the existing configuration can't be loaded, because it is corrupted for
+	// example, we just replace it:
+	profiles, err := LoadProfiles()
+	if err != nil || profiles == nil {
+		profiles = &Profiles{}
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = map[string]*Config{}
+	}
+	profiles.Profiles[profiles.Active()] = cfg
+	return SaveProfiles(profiles)
 }
 
-// loadFromOS loads the configuration from the OS keyring. If the configuration doesn't exist
-// it will return an empty configuration object.
-func loadFromOS(keyring string) (cfg *Config, err error) {
-	cfg = &Config{}
+// loadData loads the raw content of the configuration from the OS keyring first if available, or
+// from the configuration file if not. For the keyring it returns nil if there is no configuration.
+// For the file it returns an empty slice if the file doesn't exist.
+func loadData() (data []byte, err error) {
+	if keyring, ok := IsKeyringManaged(); ok {
+		return loadDataFromOS(keyring)
+	}
+	return loadDataFromFile()
+}
 
-	data, err := securestore.GetConfigFromKeyring(keyring)
+// loadDataFromOS loads the configuration from the OS keyring.
+func loadDataFromOS(keyring string) (data []byte, err error) {
+	data, err = securestore.GetConfigFromKeyring(keyring)
 	if err != nil {
 		return nil, fmt.Errorf("can't load config from OS keyring [%s]: %v", keyring, err)
 	}
@@ -74,24 +113,18 @@ func loadFromOS(keyring string) (cfg *Config, err error) {
 	if len(data) == 0 {
 		return nil, nil
 	}
-	err = json.Unmarshal(data, cfg)
-	if err != nil {
-		// Treat the config as empty if it can't be unmarshaled, it is invalid
-		return nil, nil
-	}
-	return cfg, nil
+	return data, nil
 }
 
-// loadFromFile loads the configuration from the configuration file. If the configuration file doesn't exist
-// it will return an empty configuration object.
-func loadFromFile() (cfg *Config, err error) {
+// loadDataFromFile loads the configuration from the configuration file.
+func loadDataFromFile() (data []byte, err error) {
 	file, err := Location()
 	if err != nil {
 		return
 	}
 	_, err = os.Stat(file)
 	if os.IsNotExist(err) {
-		cfg = &Config{}
+		data = []byte{}
 		err = nil
 		return
 	}
@@ -100,36 +133,17 @@ func loadFromFile() (cfg *Config, err error) {
 		return
 	}
 	// #nosec G304
-	data, err := os.ReadFile(file)
+	data, err = os.ReadFile(file)
 	if err != nil {
 		err = fmt.Errorf("can't read config file '%s': %v", file, err)
 		return
 	}
-	cfg = &Config{}
-	if len(data) == 0 {
-		return
-	}
-	err = json.Unmarshal(data, cfg)
-	if err != nil {
-		err = fmt.Errorf("can't parse config file '%s': %v", file, err)
-		return
-	}
 	return
 }
 
-// Save saves the given configuration to the configuration file.
-func Save(cfg *Config) error {
-	file, err := Location()
-	if err != nil {
-		return err
-	}
-
-	// Gosec linter rule is skipped as the field names are required by API, not hardcoded.
-	data, err := json.MarshalIndent(cfg, "", "  ") // #nosec G117
-	if err != nil {
-		return fmt.Errorf("can't marshal config: %v", err)
-	}
-
+// saveData saves the raw content of the configuration to the OS keyring if enabled, or to the
+// configuration file otherwise.
+func saveData(data []byte) error {
 	if keyring, ok := IsKeyringManaged(); ok {
 		// Use the OS keyring if the OCM_CONFIG env var is set to a valid keyring backend
 		err := securestore.UpsertConfigToKeyring(keyring, data)
@@ -139,6 +153,10 @@ func Save(cfg *Config) error {
 		return nil
 	}
 
+	file, err := Location()
+	if err != nil {
+		return err
+	}
 	dir := filepath.Dir(file)
 	err = os.MkdirAll(dir, os.FileMode(0755))
 	if err != nil {
@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to get, set and validate the settings of the configuration
+// generically, using the tags of the fields of the Config type. That way new fields automatically
+// become available to the 'ocm config' commands.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Redacted is the text displayed instead of the values of secret settings.
+const Redacted = "REDACTED"
+
+// Field describes one of the settings of the configuration.
+type Field struct {
+	name     string
+	doc      string
+	secret   bool
+	validate string
+	index    int
+}
+
+// fields contains the descriptions of all the fields of the Config type, in the order they are
+// declared.
+var fields = loadFields()
+
+// loadFields extracts the descriptions of the fields from the tags of the Config type.
+func loadFields() []*Field {
+	configType := reflect.TypeOf(Config{})
+	result := make([]*Field, 0, configType.NumField())
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		tag := field.Tag
+		name := strings.Split(tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		// Maps, like the aliases, can't be represented as a single value, so they are
+		// managed by their own commands:
+		if field.Type.Kind() == reflect.Map {
+			continue
+		}
+		secret, _ := strconv.ParseBool(tag.Get("secret"))
+		result = append(result, &Field{
+			name:     name,
+			doc:      tag.Get("doc"),
+			secret:   secret,
+			validate: tag.Get("validate"),
+			index:    i,
+		})
+	}
+	return result
+}
+
+// Fields returns the descriptions of all the settings of the configuration.
+func Fields() []*Field {
+	result := make([]*Field, len(fields))
+	copy(result, fields)
+	return result
+}
+
+// FieldNames returns the names of all the settings of the configuration.
+func FieldNames() []string {
+	result := make([]string, len(fields))
+	for i, field := range fields {
+		result[i] = field.name
+	}
+	return result
+}
+
+// LookupField returns the description of the setting with the given name. It returns an error if
+// there is no such setting.
+func LookupField(name string) (result *Field, err error) {
+	for _, field := range fields {
+		if field.name == name {
+			result = field
+			return
+		}
+	}
+	err = fmt.Errorf(
+		"unknown setting '%s', valid settings are %s",
+		name, strings.Join(FieldNames(), ", "),
+	)
+	return
+}
+
+// Name returns the name of the setting, for example `access_token`.
+func (f *Field) Name() string {
+	return f.name
+}
+
+// Doc returns the description of the setting.
+func (f *Field) Doc() string {
+	return f.doc
+}
+
+// Secret returns true if the value of the setting shouldn't be displayed by default.
+func (f *Field) Secret() bool {
+	return f.secret
+}
+
+// Type returns a short description of the type of the setting, for example `bool`.
+func (f *Field) Type() string {
+	switch f.value(&Config{}).Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Slice:
+		return "list"
+	default:
+		return "string"
+	}
+}
+
+// value returns the reflected value of the field inside the given configuration object.
+func (f *Field) value(cfg *Config) reflect.Value {
+	return reflect.ValueOf(cfg).Elem().Field(f.index)
+}
+
+// IsSet returns true if the setting has a value different to the zero value in the given
+// configuration.
+func (f *Field) IsSet(cfg *Config) bool {
+	if cfg == nil {
+		return false
+	}
+	return !f.value(cfg).IsZero()
+}
+
+// Get returns the text representation of the value of the setting in the given configuration.
+// Lists are represented as comma separated values.
+func (f *Field) Get(cfg *Config) string {
+	if cfg == nil {
+		return ""
+	}
+	value := f.value(cfg)
+	switch value.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Slice:
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = value.Index(i).String()
+		}
+		return strings.Join(items, ",")
+	default:
+		return value.String()
+	}
+}
+
+// Value returns the value of the setting in the given configuration, for example a slice of
+// strings for lists. It returns nil if the configuration is nil.
+func (f *Field) Value(cfg *Config) interface{} {
+	if cfg == nil {
+		return nil
+	}
+	return f.value(cfg).Interface()
+}
+
+// Redact returns the text representation of the value of the setting, replacing it with the
+// Redacted text if the setting is secret and isn't empty.
+func (f *Field) Redact(cfg *Config) string {
+	if f.secret && f.IsSet(cfg) {
+		return Redacted
+	}
+	return f.Get(cfg)
+}
+
+// Set parses the given text and assigns the result to the setting in the given configuration.
+// Lists are parsed as comma separated values. It returns an error if the text can't be parsed or
+// isn't valid for the setting.
+func (f *Field) Set(cfg *Config, text string) error {
+	value := f.value(cfg)
+	switch value.Kind() {
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf(
+				"value '%s' of setting '%s' isn't a valid boolean, it should be "+
+					"'true' or 'false'",
+				text, f.name,
+			)
+		}
+		value.SetBool(parsed)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(text, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		value.Set(reflect.ValueOf(items))
+	default:
+		value.SetString(text)
+	}
+	return f.check(cfg)
+}
+
+// Unset sets the setting in the given configuration to the zero value.
+func (f *Field) Unset(cfg *Config) {
+	value := f.value(cfg)
+	value.Set(reflect.Zero(value.Type()))
+}
+
+// check verifies that the value of the setting in the given configuration satisfies the
+// additional checks specified in the `validate` tag.
+func (f *Field) check(cfg *Config) error {
+	if !f.IsSet(cfg) {
+		return nil
+	}
+	switch f.validate {
+	case "":
+		return nil
+	case "url":
+		text := f.Get(cfg)
+		parsed, err := url.ParseRequestURI(text)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf(
+				"value '%s' of setting '%s' isn't a valid absolute URL",
+				text, f.name,
+			)
+		}
+		return nil
+	default:
+		return fmt.Errorf(
+			"unknown validation '%s' for setting '%s'",
+			f.validate, f.name,
+		)
+	}
+}
+
+// Validate checks that the values of all the settings of the configuration are valid.
+func (c *Config) Validate() error {
+	for _, field := range fields {
+		err := field.check(c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Fields", func() {
+	It("Contains all the fields of the configuration", func() {
+		Expect(FieldNames()).To(ContainElements(
+			"access_token",
+			"insecure",
+			"opaque_token",
+			"pager",
+			"scopes",
+			"url",
+		))
+	})
+
+	It("Rejects unknown field", func() {
+		_, err := LookupField("junk")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("unknown setting 'junk', valid settings are access_token, "))
+	})
+
+	It("Sets and gets text field", func() {
+		cfg := &Config{}
+		field, err := LookupField("pager")
+		Expect(err).ToNot(HaveOccurred())
+		err = field.Set(cfg, "less -R")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Pager).To(Equal("less -R"))
+		Expect(field.Get(cfg)).To(Equal("less -R"))
+		Expect(field.Type()).To(Equal("string"))
+	})
+
+	It("Sets and gets boolean field", func() {
+		cfg := &Config{}
+		field, err := LookupField("opaque_token")
+		Expect(err).ToNot(HaveOccurred())
+		err = field.Set(cfg, "true")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.OpaqueToken).To(BeTrue())
+		Expect(field.Get(cfg)).To(Equal("true"))
+		Expect(field.Type()).To(Equal("bool"))
+	})
+
+	It("Rejects invalid boolean", func() {
+		field, err := LookupField("insecure")
+		Expect(err).ToNot(HaveOccurred())
+		err = field.Set(&Config{}, "maybe")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("isn't a valid boolean"))
+	})
+
+	It("Sets and gets list field", func() {
+		cfg := &Config{}
+		field, err := LookupField("scopes")
+		Expect(err).ToNot(HaveOccurred())
+		err = field.Set(cfg, "openid, email")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Scopes).To(Equal([]string{"openid", "email"}))
+		Expect(field.Get(cfg)).To(Equal("openid,email"))
+		Expect(field.Value(cfg)).To(Equal([]string{"openid", "email"}))
+	})
+
+	It("Rejects invalid URL", func() {
+		field, err := LookupField("token_url")
+		Expect(err).ToNot(HaveOccurred())
+		err = field.Set(&Config{}, "not a url")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			"value 'not a url' of setting 'token_url' isn't a valid absolute URL",
+		))
+	})
+
+	It("Unsets field", func() {
+		cfg := &Config{Insecure: true}
+		field, err := LookupField("insecure")
+		Expect(err).ToNot(HaveOccurred())
+		field.Unset(cfg)
+		Expect(cfg.Insecure).To(BeFalse())
+		Expect(field.IsSet(cfg)).To(BeFalse())
+	})
+
+	It("Redacts secret fields", func() {
+		cfg := &Config{
+			ClientID:     "my-client",
+			ClientSecret: "my-secret",
+		}
+		field, err := LookupField("client_secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(field.Secret()).To(BeTrue())
+		Expect(field.Redact(cfg)).To(Equal(Redacted))
+		field, err = LookupField("client_id")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(field.Secret()).To(BeFalse())
+		Expect(field.Redact(cfg)).To(Equal("my-client"))
+	})
+
+	It("Validates complete configuration", func() {
+		cfg := &Config{
+			URL:      "https://api.openshift.com",
+			TokenURL: "junk",
+		}
+		err := cfg.Validate()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("token_url"))
+	})
+})
@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that calculate the effective configuration, combining these
+// layers, from lowest to highest priority:
+//
+//  1. The default values.
+//  2. The values saved in the configuration file or in the OS keyring.
+//  3. The values of the OCM_* environment variables, for example OCM_CLIENT_ID.
+//  4. The values of the command line flags that correspond to settings, for example
+//     '--opaque-token'.
+//
+// That way it is possible, for example in CI environments, to use the tool without writing a
+// configuration file to disk.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+// defaultURL is the default URL of the API gateway. This is the same than the DefaultURL constant
+// of the SDK, but that is in a package that we don't want to import here.
+const defaultURL = "https://api.openshift.com"
+
+// OriginKind indicates the layer where the effective value of a setting comes from.
+type OriginKind string
+
+const (
+	OriginDefault OriginKind = "default"
+	OriginFile    OriginKind = "file"
+	OriginKeyring OriginKind = "keyring"
+	OriginEnv     OriginKind = "env"
+	OriginFlag    OriginKind = "flag"
+)
+
+// Origin describes where the effective value of a setting comes from. The source is the name of the
+// configuration file, keyring, environment variable or command line flag.
+type Origin struct {
+	Kind   OriginKind
+	Source string
+}
+
+// String returns the text representation of the origin, for example `env:OCM_CLIENT_ID`.
+func (o Origin) String() string {
+	if o.Source == "" {
+		return string(o.Kind)
+	}
+	return fmt.Sprintf("%s:%s", o.Kind, o.Source)
+}
+
+// flagOverride describes a command line flag that overrides a setting.
+type flagOverride struct {
+	flags *pflag.FlagSet
+	flag  string
+	field string
+}
+
+// flagOverrides contains the command line flags that override settings.
+var flagOverrides []flagOverride
+
+// AddFlagOverride indicates that the given command line flag overrides the given setting when it
+// is explicitly used.
+func AddFlagOverride(flags *pflag.FlagSet, flag, field string) {
+	flagOverrides = append(flagOverrides, flagOverride{
+		flags: flags,
+		flag:  flag,
+		field: field,
+	})
+}
+
+// EnvKey returns the name of the environment variable that overrides the setting, for example
+// `OCM_CLIENT_ID` for the `client_id` setting.
+func (f *Field) EnvKey() string {
+	return properties.ConfigEnvPrefix + strings.ToUpper(f.name)
+}
+
+// Load loads the effective configuration of the active profile, combining the default values, the
+// values saved in the configuration file or in the OS keyring, the environment variables and the
+// command line flags. It returns nil if there is no saved configuration and no setting has been
+// overridden.
+func Load() (cfg *Config, err error) {
+	cfg, _, err = LoadWithOrigins()
+	return
+}
+
+// LoadWithOrigins is like Load, but it also returns a map containing the origin of the effective
+// value of each setting. Settings that don't have any value don't appear in the map.
+func LoadWithOrigins() (cfg *Config, origins map[string]Origin, err error) {
+	return loadLayers(false)
+}
+
+// LoadEffective is like LoadWithOrigins, but when there is no saved configuration and no setting
+// has been overridden it returns the default values instead of nil. This is intended for commands
+// that display the configuration.
+func LoadEffective() (cfg *Config, origins map[string]Origin, err error) {
+	return loadLayers(true)
+}
+
+// loadLayers combines the layers of the configuration. If the always flag is false it returns nil
+// when there is no saved configuration and no setting has been overridden.
+func loadLayers(always bool) (cfg *Config, origins map[string]Origin, err error) {
+	stored, err := LoadStored()
+	if err != nil {
+		return
+	}
+	overrides, overridesOrigins, err := loadOverrides()
+	if err != nil {
+		return
+	}
+	if stored == nil && len(overrides) == 0 && !always {
+		return
+	}
+
+	// Start with the default values:
+	cfg = loadDefaults()
+	origins = map[string]Origin{}
+	for _, field := range fields {
+		if field.IsSet(cfg) {
+			origins[field.name] = Origin{Kind: OriginDefault}
+		}
+	}
+
+	// Apply the saved values:
+	if stored != nil {
+		storedOrigin := storedOrigin()
+		for _, field := range fields {
+			if field.IsSet(stored) {
+				field.value(cfg).Set(field.value(stored))
+				origins[field.name] = storedOrigin
+			}
+		}
+		cfg.Aliases = stored.Aliases
+	}
+
+	// Apply the values from the environment and the command line flags. Invalid values of
+	// environment variables are ignored with a warning, so that a wrong variable doesn't break
+	// every command, but invalid values of flags are errors:
+	for _, field := range fields {
+		value, ok := overrides[field.name]
+		if !ok {
+			continue
+		}
+		origin := overridesOrigins[field.name]
+		updated := *cfg
+		err = field.Set(&updated, value)
+		if err != nil && origin.Kind == OriginEnv {
+			fmt.Fprintf(
+				os.Stderr,
+				"Warning: ignoring environment variable '%s': %v\n",
+				origin.Source, err,
+			)
+			err = nil
+			continue
+		}
+		if err != nil {
+			err = fmt.Errorf("invalid value from %s: %v", origin, err)
+			return
+		}
+		*cfg = updated
+		origins[field.name] = origin
+	}
+
+	return
+}
+
+// Save saves the given configuration as the configuration of the active profile. Values that are
+// equal to the defaults, or to the values that come from environment variables or command line
+// flags, aren't saved: the previously saved value is preserved instead. That way the overrides
+// aren't written to disk when commands save the configuration, for example to save refreshed
+// tokens.
+func Save(cfg *Config) error {
+	stored, err := LoadStored()
+	if err != nil || stored == nil {
+		stored = &Config{}
+	}
+	overrides, _, err := loadOverrides()
+	if err != nil {
+		return err
+	}
+	defaults := loadDefaults()
+	result := *cfg
+	for _, field := range fields {
+		value := field.Get(cfg)
+		override, overridden := overrides[field.name]
+		defaulted := field.IsSet(defaults) && value == field.Get(defaults) && !field.IsSet(stored)
+		if (overridden && value == override) || defaulted {
+			field.value(&result).Set(field.value(stored))
+		}
+	}
+
+	// The aliases are only changed by the 'ocm alias' commands, using the SaveStored function,
+	// so always preserve the saved ones. Otherwise commands that create a new configuration,
+	// like 'ocm login', would remove them.
+	result.Aliases = stored.Aliases
+	return SaveStored(&result)
+}
+
+// loadDefaults returns the default values of the settings. These are the same values that the SDK
+// uses when the settings aren't explicitly set.
+func loadDefaults() *Config {
+	scopes := make([]string, len(authentication.DefaultScopes))
+	copy(scopes, authentication.DefaultScopes)
+	return &Config{
+		ClientID: authentication.DefaultClientID,
+		Scopes:   scopes,
+		TokenURL: authentication.DefaultTokenURL,
+		URL:      defaultURL,
+	}
+}
+
+// loadOverrides returns the text of the values overridden by environment variables and command
+// line flags, and the origins of those values.
+func loadOverrides() (values map[string]string, origins map[string]Origin, err error) {
+	values = map[string]string{}
+	origins = map[string]Origin{}
+	for _, field := range fields {
+		envKey := field.EnvKey()
+		value, ok := os.LookupEnv(envKey)
+		if !ok || value == "" {
+			continue
+		}
+		values[field.name] = value
+		origins[field.name] = Origin{Kind: OriginEnv, Source: envKey}
+	}
+	for _, override := range flagOverrides {
+		flag := override.flags.Lookup(override.flag)
+		if flag == nil || !flag.Changed {
+			continue
+		}
+		_, err = LookupField(override.field)
+		if err != nil {
+			return
+		}
+		values[override.field] = flag.Value.String()
+		origins[override.field] = Origin{Kind: OriginFlag, Source: "--" + override.flag}
+	}
+	return
+}
+
+// storedOrigin returns the origin of the saved values, the OS keyring or the configuration file.
+func storedOrigin() Origin {
+	if keyring, ok := IsKeyringManaged(); ok {
+		return Origin{Kind: OriginKeyring, Source: keyring}
+	}
+	file, _ := Location()
+	return Origin{Kind: OriginFile, Source: file}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+var _ = Describe("Layers", func() {
+	var dir string
+	var file string
+
+	// setEnv sets an environment variable, restoring the original value when the test finishes.
+	setEnv := func(name, value string) {
+		original, ok := os.LookupEnv(name)
+		if ok {
+			DeferCleanup(os.Setenv, name, original)
+		} else {
+			DeferCleanup(os.Unsetenv, name)
+		}
+		Expect(os.Setenv(name, value)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a temporary directory for the configuration file:
+		dir, err = os.MkdirTemp("", "ocm-test-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		file = filepath.Join(dir, "ocm.json")
+		setEnv("OCM_CONFIG", file)
+		setEnv(properties.KeyringEnvKey, "")
+		setEnv(properties.ProfileEnvKey, "")
+		for _, field := range fields {
+			setEnv(field.EnvKey(), "")
+		}
+		flagOverrides = nil
+	})
+
+	AfterEach(func() {
+		flagOverrides = nil
+		err := os.RemoveAll(dir)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Uses defaults when the file doesn't set the value", func() {
+		err := os.WriteFile(file, []byte(`{"client_id": "my-client"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		cfg, origins, err := LoadWithOrigins()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://api.openshift.com"))
+		Expect(cfg.ClientID).To(Equal("my-client"))
+		Expect(origins["url"]).To(Equal(Origin{Kind: OriginDefault}))
+		Expect(origins["client_id"]).To(Equal(Origin{Kind: OriginFile, Source: file}))
+		Expect(origins).ToNot(HaveKey("pager"))
+	})
+
+	It("Uses defaults when there is no configuration file", func() {
+		cfg, err := Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg).ToNot(BeNil())
+		Expect(cfg.URL).To(Equal("https://api.openshift.com"))
+	})
+
+	It("Overrides file values with environment variables", func() {
+		err := os.WriteFile(file, []byte(`{"client_id": "my-client", "pager": "less"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		setEnv("OCM_CLIENT_ID", "your-client")
+		setEnv("OCM_INSECURE", "true")
+		setEnv("OCM_SCOPES", "openid,email")
+		cfg, origins, err := LoadWithOrigins()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.ClientID).To(Equal("your-client"))
+		Expect(cfg.Insecure).To(BeTrue())
+		Expect(cfg.Scopes).To(Equal([]string{"openid", "email"}))
+		Expect(cfg.Pager).To(Equal("less"))
+		Expect(origins["client_id"].String()).To(Equal("env:OCM_CLIENT_ID"))
+	})
+
+	It("Ignores invalid environment variable", func() {
+		err := os.WriteFile(file, []byte(`{"client_id": "my-client"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		setEnv("OCM_OPAQUE_TOKEN", "junk")
+		setEnv("OCM_PAGER", "less")
+		cfg, origins, err := LoadWithOrigins()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.OpaqueToken).To(BeFalse())
+		Expect(cfg.Pager).To(Equal("less"))
+		Expect(origins).ToNot(HaveKey("opaque_token"))
+	})
+
+	It("Rejects invalid flag", func() {
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.String("insecure", "", "")
+		AddFlagOverride(flags, "insecure", "insecure")
+		Expect(flags.Parse([]string{"--insecure", "junk"})).To(Succeed())
+		_, err := Load()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("flag:--insecure"))
+	})
+
+	It("Returns the defaults for display when there is no saved configuration", func() {
+		cfg, origins, err := LoadEffective()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg).ToNot(BeNil())
+		Expect(cfg.URL).To(Equal("https://api.openshift.com"))
+		Expect(origins["url"]).To(Equal(Origin{Kind: OriginDefault}))
+	})
+
+	It("Overrides environment variables with flags", func() {
+		setEnv("OCM_PAGER", "less")
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.String("pager", "", "")
+		AddFlagOverride(flags, "pager", "pager")
+		Expect(flags.Parse([]string{"--pager", "more"})).To(Succeed())
+		cfg, origins, err := LoadWithOrigins()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Pager).To(Equal("more"))
+		Expect(origins["pager"].String()).To(Equal("flag:--pager"))
+	})
+
+	It("Doesn't save overridden and default values", func() {
+		err := os.WriteFile(file, []byte(`{"client_id": "my-client"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		setEnv("OCM_CLIENT_SECRET", "my-secret")
+		cfg, err := Load()
+		Expect(err).ToNot(HaveOccurred())
+		cfg.AccessToken = "my-token"
+		err = Save(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"access_token": "my-token",
+			"client_id": "my-client"
+		}`))
+	})
+
+	It("Loads the aliases and preserves them when saving a new configuration", func() {
+		err := os.WriteFile(file, []byte(`{
+			"client_id": "my-client",
+			"aliases": {
+				"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}"
+			}
+		}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		cfg, err := Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.Aliases).To(HaveKeyWithValue(
+			"mp", "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}",
+		))
+		err = Save(&Config{
+			AccessToken: "my-token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		data, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"access_token": "my-token",
+			"aliases": {
+				"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}"
+			}
+		}`))
+	})
+})
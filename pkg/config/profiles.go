@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions used to manage multiple named configurations, called
+// profiles, in the same configuration file or keyring item.
+//
+// Configuration files created before profiles were introduced contain the settings directly in the
+// top level object. Those settings are loaded as the default profile, and the file is written in
+// the same format while the default profile is the only one. When other profiles are added the file
+// is migrated to this format:
+//
+//	{
+//	  "current_profile": "staging",
+//	  "profiles": {
+//	    "default": {
+//	      "url": "https://api.openshift.com",
+//	      ...
+//	    },
+//	    "staging": {
+//	      "url": "https://api.stage.openshift.com",
+//	      ...
+//	    }
+//	  }
+//	}
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+// DefaultProfile is the name of the profile used when no other profile has been selected. It is also
+// the name given to the settings of configuration files created before profiles were introduced.
+const DefaultProfile = "default"
+
+// Profiles is the type used to store the complete configuration, containing all the profiles.
+type Profiles struct {
+	Current  string             `json:"current_profile,omitempty"`
+	Profiles map[string]*Config `json:"profiles,omitempty"`
+}
+
+// profile is the name of the profile selected with the '--profile' command line flag.
+var profile string
+
+// AddProfileFlag adds the '--profile' flag to the given set of command line flags.
+func AddProfileFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&profile,
+		"profile",
+		"",
+		"Name of the configuration profile to use. Can also be set with the "+
+			properties.ProfileEnvKey+" environment variable. The default is to use the "+
+			"profile selected with the 'ocm config use-profile' command.",
+	)
+}
+
+// SelectedProfile returns the name of the profile explicitly selected with the '--profile' command
+// line flag or with the OCM_PROFILE environment variable, or an empty string if no profile has been
+// explicitly selected.
+func SelectedProfile() string {
+	if profile != "" {
+		return profile
+	}
+	return os.Getenv(properties.ProfileEnvKey)
+}
+
+// LoadProfiles loads all the profiles from the OS keyring if available, or from the configuration
+// file if not. For the keyring it returns nil if there is no configuration.
+func LoadProfiles() (result *Profiles, err error) {
+	data, err := loadData()
+	if err != nil || data == nil {
+		return
+	}
+	result, err = parseProfiles(data)
+	if err != nil {
+		if _, ok := IsKeyringManaged(); ok {
+			// Treat the config as empty if it can't be unmarshaled, it is invalid
+			result, err = nil, nil
+			return
+		}
+		file, _ := Location()
+		err = fmt.Errorf("can't parse config file '%s': %v", file, err)
+	}
+	return
+}
+
+// SaveProfiles saves all the profiles to the OS keyring if enabled, or to the configuration file
+// otherwise.
+func SaveProfiles(profiles *Profiles) error {
+	data, err := profiles.marshal()
+	if err != nil {
+		return fmt.Errorf("can't marshal config: %v", err)
+	}
+	return saveData(data)
+}
+
+// parseProfiles parses the configuration, accepting both the format with profiles and the format
+// used before profiles were introduced.
+func parseProfiles(data []byte) (result *Profiles, err error) {
+	result = &Profiles{
+		Profiles: map[string]*Config{},
+	}
+	if len(data) == 0 {
+		return
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return
+	}
+	if _, ok := fields["profiles"]; ok {
+		err = json.Unmarshal(data, result)
+		if err != nil {
+			return
+		}
+		if result.Profiles == nil {
+			result.Profiles = map[string]*Config{}
+		}
+		return
+	}
+	cfg := &Config{}
+	err = json.Unmarshal(data, cfg)
+	if err != nil {
+		return
+	}
+	result.Profiles[DefaultProfile] = cfg
+	return
+}
+
+// marshal generates the JSON representation of the profiles. If the only profile is the default one
+// then the format used before profiles were introduced is preserved, so that older versions of the
+// tool can still use it.
+func (p *Profiles) marshal() ([]byte, error) {
+	// Gosec linter rule is skipped as the field names are required by API, not hardcoded.
+	if p.legacy() {
+		cfg := p.Profiles[DefaultProfile]
+		if cfg == nil {
+			cfg = &Config{}
+		}
+		return json.MarshalIndent(cfg, "", "  ") // #nosec G117
+	}
+	return json.MarshalIndent(p, "", "  ") // #nosec G117
+}
+
+// legacy checks if the profiles can be saved using the format used before profiles were
+// introduced.
+func (p *Profiles) legacy() bool {
+	if p.Current != "" && p.Current != DefaultProfile {
+		return false
+	}
+	for name := range p.Profiles {
+		if name != DefaultProfile {
+			return false
+		}
+	}
+	return true
+}
+
+// Active returns the name of the active profile. That is the profile explicitly selected with the
+// '--profile' command line flag or the OCM_PROFILE environment variable, or else the current
+// profile stored in the configuration, or else the default profile.
+func (p *Profiles) Active() string {
+	if selected := SelectedProfile(); selected != "" {
+		return selected
+	}
+	if p.Current != "" {
+		return p.Current
+	}
+	return DefaultProfile
+}
+
+// Names returns the sorted names of the profiles.
+func (p *Profiles) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Use makes the given profile the current one. It returns an error if there is no profile with that
+// name.
+func (p *Profiles) Use(name string) error {
+	if _, ok := p.Profiles[name]; !ok {
+		names := p.Names()
+		if len(names) == 0 {
+			return fmt.Errorf("profile '%s' doesn't exist, there are no profiles", name)
+		}
+		return fmt.Errorf(
+			"profile '%s' doesn't exist, valid profiles are %s",
+			name, strings.Join(names, ", "),
+		)
+	}
+	p.Current = name
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+var _ = Describe("Profiles", func() {
+	var dir string
+	var file string
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a temporary directory for the configuration file:
+		dir, err = os.MkdirTemp("", "ocm-test-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		file = filepath.Join(dir, "ocm.json")
+
+		// Make sure that the environment doesn't affect the tests:
+		DeferCleanup(os.Setenv, "OCM_CONFIG", os.Getenv("OCM_CONFIG"))
+		DeferCleanup(os.Setenv, properties.KeyringEnvKey, os.Getenv(properties.KeyringEnvKey))
+		DeferCleanup(os.Setenv, properties.ProfileEnvKey, os.Getenv(properties.ProfileEnvKey))
+		Expect(os.Setenv("OCM_CONFIG", file)).To(Succeed())
+		Expect(os.Unsetenv(properties.KeyringEnvKey)).To(Succeed())
+		Expect(os.Unsetenv(properties.ProfileEnvKey)).To(Succeed())
+		profile = ""
+	})
+
+	AfterEach(func() {
+		profile = ""
+		err := os.RemoveAll(dir)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// readFile reads the configuration file and returns the top level fields.
+	readFile := func() map[string]interface{} {
+		data, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		var result map[string]interface{}
+		err = json.Unmarshal(data, &result)
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	It("Loads legacy file as the default profile", func() {
+		err := os.WriteFile(file, []byte(`{"url": "https://my.example.com"}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		profiles, err := LoadProfiles()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profiles.Names()).To(ConsistOf(DefaultProfile))
+		Expect(profiles.Active()).To(Equal(DefaultProfile))
+		cfg, err := Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://my.example.com"))
+	})
+
+	It("Preserves legacy format when there is only the default profile", func() {
+		err := Save(&Config{URL: "https://my.example.com"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readFile()).To(Equal(map[string]interface{}{
+			"url": "https://my.example.com",
+		}))
+	})
+
+	It("Migrates to the profiles format when a profile is added", func() {
+		err := Save(&Config{URL: "https://prod.example.com"})
+		Expect(err).ToNot(HaveOccurred())
+		profile = "staging"
+		err = Save(&Config{URL: "https://stage.example.com"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readFile()).To(HaveKey("profiles"))
+
+		// The selected profile should be loaded:
+		cfg, err := Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://stage.example.com"))
+
+		// The default profile should be preserved:
+		profile = ""
+		cfg, err = Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.URL).To(Equal("https://prod.example.com"))
+	})
+
+	It("Uses the profile selected with the environment variable", func() {
+		profiles := &Profiles{
+			Current: "b",
+			Profiles: map[string]*Config{
+				"a": {URL: "https://a.example.com"},
+				"b": {URL: "https://b.example.com"},
+				"c": {URL: "https://c.example.com"},
+			},
+		}
+		Expect(profiles.Active()).To(Equal("b"))
+		Expect(os.Setenv(properties.ProfileEnvKey, "c")).To(Succeed())
+		Expect(profiles.Active()).To(Equal("c"))
+		profile = "a"
+		Expect(profiles.Active()).To(Equal("a"))
+	})
+
+	It("Changes the current profile", func() {
+		profiles := &Profiles{
+			Profiles: map[string]*Config{
+				DefaultProfile: {},
+				"staging":      {},
+			},
+		}
+		err := profiles.Use("staging")
+		Expect(err).ToNot(HaveOccurred())
+		err = SaveProfiles(profiles)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readFile()).To(HaveKeyWithValue("current_profile", "staging"))
+	})
+
+	It("Rejects unknown profile", func() {
+		profiles := &Profiles{
+			Profiles: map[string]*Config{
+				DefaultProfile: {},
+				"staging":      {},
+			},
+		}
+		err := profiles.Use("junk")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			"profile 'junk' doesn't exist, valid profiles are default, staging",
+		))
+	})
+})
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that describe the authentication status of the configuration,
+// used to diagnose login problems.
+
+package config
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Authentication methods returned in the status:
+const (
+	AuthMethodNone              = "none"
+	AuthMethodOpaqueToken       = "opaque-token"
+	AuthMethodClientCredentials = "client-credentials"
+	AuthMethodPassword          = "password"
+	AuthMethodOfflineToken      = "offline-token"
+	AuthMethodRefreshToken      = "refresh-token"
+	AuthMethodAccessToken       = "access-token"
+)
+
+// Status describes the authentication status of the configuration.
+type Status struct {
+	LoggedIn     bool         `json:"logged_in"`
+	Reason       string       `json:"reason,omitempty"`
+	Profile      string       `json:"profile,omitempty"`
+	Storage      string       `json:"storage,omitempty"`
+	Location     string       `json:"location,omitempty"`
+	URL          string       `json:"url,omitempty"`
+	TokenURL     string       `json:"token_url,omitempty"`
+	ClientID     string       `json:"client_id,omitempty"`
+	AuthMethod   string       `json:"auth_method"`
+	AccessToken  *TokenStatus `json:"access_token,omitempty"`
+	RefreshToken *TokenStatus `json:"refresh_token,omitempty"`
+}
+
+// TokenStatus describes one of the tokens of the configuration. The claims are only available
+// when the token is a JWT.
+type TokenStatus struct {
+	Format    string     `json:"format"`
+	Type      string     `json:"type,omitempty"`
+	Issuer    string     `json:"issuer,omitempty"`
+	Subject   string     `json:"subject,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Expired   bool       `json:"expired"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// Token formats returned in the status:
+const (
+	TokenFormatJWT       = "jwt"
+	TokenFormatEncrypted = "encrypted"
+	TokenFormatOpaque    = "opaque"
+)
+
+// Status calculates the authentication status of the configuration. Problems with the tokens, for
+// example tokens that can't be parsed, are reported inside the result instead of as errors, so
+// that they can be displayed to the user.
+func (c *Config) Status(opaqueMode bool) *Status {
+	result := &Status{
+		URL:      c.URL,
+		TokenURL: c.TokenURL,
+		ClientID: c.ClientID,
+	}
+
+	// Check the tokens:
+	if c.AccessToken != "" {
+		result.AccessToken = tokenStatus(c.AccessToken, opaqueMode)
+	}
+	if c.RefreshToken != "" {
+		result.RefreshToken = tokenStatus(c.RefreshToken, opaqueMode)
+	}
+
+	// Check if it is usable, and the reason if it isn't:
+	armed, reason, err := c.Armed(opaqueMode)
+	switch {
+	case err != nil:
+		result.Reason = err.Error()
+	case !armed:
+		result.Reason = reason
+	default:
+		result.LoggedIn = true
+	}
+
+	// Determine the authentication method. When there are both tokens and credentials the
+	// tokens are used first, and the credentials only when the tokens expire.
+	switch {
+	case opaqueMode && c.AccessToken != "":
+		result.AuthMethod = AuthMethodOpaqueToken
+	case result.RefreshToken != nil && result.RefreshToken.Type == "Offline":
+		result.AuthMethod = AuthMethodOfflineToken
+	case result.RefreshToken != nil:
+		result.AuthMethod = AuthMethodRefreshToken
+	case c.ClientID != "" && c.ClientSecret != "":
+		result.AuthMethod = AuthMethodClientCredentials
+	case c.User != "" && c.Password != "":
+		result.AuthMethod = AuthMethodPassword
+	case result.AccessToken != nil:
+		result.AuthMethod = AuthMethodAccessToken
+	default:
+		result.AuthMethod = AuthMethodNone
+	}
+
+	return result
+}
+
+// tokenStatus describes the given token.
+func tokenStatus(text string, opaqueMode bool) *TokenStatus {
+	result := &TokenStatus{}
+	switch {
+	case opaqueMode || (!IsJWTToken(text) && !IsEncryptedToken(text)):
+		result.Format = TokenFormatOpaque
+		return result
+	case IsEncryptedToken(text):
+		result.Format = TokenFormatEncrypted
+		return result
+	}
+	result.Format = TokenFormatJWT
+	token, err := ParseToken(text)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Type, err = TokenType(token)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if ok {
+		result.Issuer, _ = claims["iss"].(string)
+		result.Subject, _ = claims["sub"].(string)
+	}
+	expiry, expires, err := TokenExpiry(token)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if expires {
+		expiry = expiry.UTC()
+		result.ExpiresAt = &expiry
+		result.Expired = time.Now().After(expiry)
+	}
+	return result
+}
+
+// LoadStatus loads the effective configuration of the active profile and calculates its status,
+// including the name of the profile and where the configuration is stored.
+func LoadStatus(opaqueMode bool) (result *Status, err error) {
+	cfg, err := Load()
+	if err != nil {
+		return
+	}
+	if cfg == nil {
+		cfg = loadDefaults()
+	}
+	result = cfg.Status(opaqueMode || cfg.OpaqueToken)
+	profiles, err := LoadProfiles()
+	if err != nil {
+		return
+	}
+	if profiles == nil {
+		profiles = &Profiles{}
+	}
+	result.Profile = profiles.Active()
+	origin := storedOrigin()
+	result.Storage = string(origin.Kind)
+	result.Location = origin.Source
+	return
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Fake test credentials are used in this file.
+// This is not a security issue, so this rule is being skipped for this file.
+// #nosec G101
+
+package config
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Status", func() {
+	It("Describes offline token", func() {
+		cfg := &Config{
+			AccessToken:  MakeTokenString("Bearer", 5*time.Minute),
+			RefreshToken: MakeTokenString("Offline", 10*time.Hour),
+			URL:          "http://my-server.example.com",
+			TokenURL:     "http://my-sso.example.com",
+		}
+		status := cfg.Status(false)
+		Expect(status.LoggedIn).To(BeTrue())
+		Expect(status.Reason).To(BeEmpty())
+		Expect(status.AuthMethod).To(Equal(AuthMethodOfflineToken))
+		Expect(status.AccessToken).ToNot(BeNil())
+		Expect(status.AccessToken.Format).To(Equal(TokenFormatJWT))
+		Expect(status.AccessToken.Type).To(Equal("Bearer"))
+		Expect(status.AccessToken.Issuer).To(Equal("https://sso.redhat.com/auth/realms/redhat-external"))
+		Expect(status.AccessToken.ExpiresAt).ToNot(BeNil())
+		Expect(*status.AccessToken.ExpiresAt).To(BeTemporally("~", time.Now().Add(5*time.Minute), time.Minute))
+		Expect(status.AccessToken.Expired).To(BeFalse())
+		Expect(status.RefreshToken).ToNot(BeNil())
+		Expect(status.RefreshToken.Type).To(Equal("Offline"))
+		Expect(status.RefreshToken.Expired).To(BeFalse())
+	})
+
+	It("Reports expired tokens", func() {
+		cfg := &Config{
+			AccessToken:  MakeTokenString("Bearer", -5*time.Minute),
+			RefreshToken: MakeTokenString("Refresh", -1*time.Minute),
+			URL:          "http://my-server.example.com",
+			TokenURL:     "http://my-sso.example.com",
+		}
+		status := cfg.Status(false)
+		Expect(status.LoggedIn).To(BeFalse())
+		Expect(status.Reason).To(Equal("access and refresh tokens are expired"))
+		Expect(status.AuthMethod).To(Equal(AuthMethodRefreshToken))
+		Expect(status.AccessToken.Expired).To(BeTrue())
+		Expect(status.RefreshToken.Expired).To(BeTrue())
+	})
+
+	It("Extracts the subject", func() {
+		token := MakeTokenObject(jwt.MapClaims{
+			"typ": "Bearer",
+			"sub": "my-user-id",
+		})
+		cfg := &Config{
+			AccessToken: token.Raw,
+		}
+		status := cfg.Status(false)
+		Expect(status.AccessToken.Subject).To(Equal("my-user-id"))
+		Expect(status.AuthMethod).To(Equal(AuthMethodAccessToken))
+	})
+
+	It("Describes client credentials", func() {
+		cfg := &Config{
+			ClientID:     "my-client",
+			ClientSecret: "my-secret",
+			URL:          "http://my-server.example.com",
+			TokenURL:     "http://my-sso.example.com",
+		}
+		status := cfg.Status(false)
+		Expect(status.LoggedIn).To(BeTrue())
+		Expect(status.AuthMethod).To(Equal(AuthMethodClientCredentials))
+		Expect(status.ClientID).To(Equal("my-client"))
+		Expect(status.AccessToken).To(BeNil())
+	})
+
+	It("Describes opaque token", func() {
+		cfg := &Config{
+			AccessToken: "my-opaque-token",
+			URL:         "http://my-server.example.com",
+			TokenURL:    "http://my-sso.example.com",
+		}
+		status := cfg.Status(true)
+		Expect(status.LoggedIn).To(BeTrue())
+		Expect(status.AuthMethod).To(Equal(AuthMethodOpaqueToken))
+		Expect(status.AccessToken.Format).To(Equal(TokenFormatOpaque))
+	})
+
+	It("Reports missing credentials", func() {
+		status := (&Config{}).Status(false)
+		Expect(status.LoggedIn).To(BeFalse())
+		Expect(status.Reason).To(Equal("credentials aren't set"))
+		Expect(status.AuthMethod).To(Equal(AuthMethodNone))
+	})
+})
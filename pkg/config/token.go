@@ -90,6 +90,17 @@ func tokenUsable(token string, margin time.Duration) (usable bool, err error) {
 
 // tokenExpiration determines if the given token expires, and the time that remains till it expires.
 func tokenExpiration(token *jwt.Token) (expires bool, left time.Duration, err error) {
+	expiry, expires, err := TokenExpiry(token)
+	if err != nil || !expires {
+		return
+	}
+	left = time.Until(expiry)
+	return
+}
+
+// TokenExpiry extracts the value of the `exp` claim. It returns the time when the token expires, or
+// false if the token doesn't expire.
+func TokenExpiry(token *jwt.Token) (expiry time.Time, expires bool, err error) {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
 		err = fmt.Errorf("expected map claims bug got %T", claims)
@@ -108,7 +119,7 @@ func tokenExpiration(token *jwt.Token) (expires bool, left time.Duration, err er
 		return
 	}
 	expires = true
-	left = time.Until(time.Unix(int64(exp), 0))
+	expiry = time.Unix(int64(exp), 0)
 	return
 }
 
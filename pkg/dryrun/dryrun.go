@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun contains a transport that describes the requests that would be sent to the API,
+// either in a human readable format or as a curl command, instead of sending them.
+package dryrun
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/roundtrip"
+)
+
+// AddFlags adds the '--dry-run' and '--print-curl' flags to the given set of command line flags.
+func AddFlags(fs *pflag.FlagSet, dryRun *bool, printCurl *bool) {
+	fs.BoolVar(
+		dryRun,
+		"dry-run",
+		false,
+		"Print the method, URL, headers and body of the request instead of sending it. "+
+			"The bearer token is redacted.",
+	)
+	fs.BoolVar(
+		printCurl,
+		"print-curl",
+		false,
+		"Print an equivalent curl command instead of sending the request. The bearer "+
+			"token is replaced by a call to 'ocm token'.",
+	)
+}
+
+// secretHeaders are the headers whose values are redacted.
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+}
+
+// Wrapper returns a transport wrapper that writes the description of each request to the given
+// writer, in the human readable format or as a curl command, and then returns an empty response
+// without sending it. Requests for the given token URL are sent, because the SDK also uses the
+// wrapper for the requests that refresh the tokens, and those must not be written as they contain
+// credentials.
+func Wrapper(writer io.Writer, curl bool, tokenURL string) func(http.RoundTripper) http.RoundTripper {
+	return func(wrapped http.RoundTripper) http.RoundTripper {
+		return &transport{
+			wrapped:  wrapped,
+			writer:   writer,
+			curl:     curl,
+			tokenURL: tokenURL,
+		}
+	}
+}
+
+// transport is the round tripper returned by the wrapper.
+type transport struct {
+	wrapped  http.RoundTripper
+	writer   io.Writer
+	curl     bool
+	tokenURL string
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *transport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	if roundtrip.IsTokenRequest(request, t.tokenURL) {
+		return t.wrapped.RoundTrip(request)
+	}
+	var body []byte
+	if request.Body != nil {
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return
+		}
+		err = request.Body.Close()
+		if err != nil {
+			return
+		}
+	}
+	if t.curl {
+		err = WriteCurl(t.writer, request, body)
+	} else {
+		err = Write(t.writer, request, body)
+	}
+	if err != nil {
+		return
+	}
+	response = &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      request.Proto,
+		ProtoMajor: request.ProtoMajor,
+		ProtoMinor: request.ProtoMinor,
+		Header:     http.Header{},
+		Body:       io.NopCloser(&bytes.Buffer{}),
+		Request:    request,
+	}
+	return
+}
+
+// Write writes the method, URL, headers and body of the given request, in a format similar to the
+// HTTP protocol. The values of headers that contain credentials are redacted.
+func Write(writer io.Writer, request *http.Request, body []byte) error {
+	buffer := &bytes.Buffer{}
+	fmt.Fprintf(buffer, "%s %s\n", request.Method, request.URL)
+	for _, name := range headerNames(request.Header) {
+		for _, value := range request.Header.Values(name) {
+			fmt.Fprintf(buffer, "%s: %s\n", name, redact(name, value))
+		}
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(buffer, "\n%s", body)
+		if !bytes.HasSuffix(body, []byte("\n")) {
+			buffer.WriteString("\n")
+		}
+	}
+	_, err := writer.Write(buffer.Bytes())
+	return err
+}
+
+// WriteCurl writes a curl command equivalent to the given request. The bearer token is replaced
+// by a call to the 'ocm token' command, so that the command can be copied and executed without
+// exposing the token.
+func WriteCurl(writer io.Writer, request *http.Request, body []byte) error {
+	lines := []string{
+		fmt.Sprintf("curl --request %s", request.Method),
+		fmt.Sprintf("--url %s", quote(request.URL.String())),
+	}
+	for _, name := range headerNames(request.Header) {
+		for _, value := range request.Header.Values(name) {
+			var arg string
+			if name == "Authorization" && strings.HasPrefix(value, "Bearer ") {
+				arg = `"Authorization: Bearer $(ocm token)"`
+			} else {
+				arg = quote(fmt.Sprintf("%s: %s", name, redact(name, value)))
+			}
+			lines = append(lines, fmt.Sprintf("--header %s", arg))
+		}
+	}
+	if len(body) > 0 {
+		lines = append(lines, fmt.Sprintf("--data-binary %s", quote(string(body))))
+	}
+	_, err := fmt.Fprintf(writer, "%s\n", strings.Join(lines, " \\\n  "))
+	return err
+}
+
+// headerNames returns the sorted names of the given headers, so that the output is stable.
+func headerNames(header http.Header) []string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// redact replaces the credentials contained in the value of the given header, preserving the
+// authentication scheme, for example 'Bearer'.
+func redact(name, value string) string {
+	if !secretHeaders[http.CanonicalHeaderKey(name)] {
+		return value
+	}
+	scheme, _, found := strings.Cut(value, " ")
+	if !found {
+		return config.Redacted
+	}
+	return scheme + " " + config.Redacted
+}
+
+// quote quotes the given text so that it can be safely used as a single argument in a POSIX
+// shell.
+func quote(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", `'\''`) + "'"
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions used to filter JSON documents with jq expressions before
+// dumping them, so that an external jq binary isn't needed.
+
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/pflag"
+)
+
+// AddQueryFlags adds the '--jq' and '--raw-output' flags to the given set of command line flags.
+func AddQueryFlags(fs *pflag.FlagSet, expr *string, raw *bool) {
+	fs.StringVar(
+		expr,
+		"jq",
+		"",
+		"Filter the response body with the given jq expression, for example "+
+			"'.items[].id'. Each result is written separately.",
+	)
+	fs.BoolVarP(
+		raw,
+		"raw-output",
+		"r",
+		false,
+		"Used together with '--jq', write strings without quotes.",
+	)
+}
+
+// Query is a compiled jq expression. Don't create instances of this type directly, use the
+// ParseQuery function instead.
+type Query struct {
+	code *gojq.Code
+	raw  bool
+}
+
+// ParseQuery parses and compiles the given jq expression. When raw is true the results that are
+// strings are written without quotes, like the '--raw-output' option of jq.
+func ParseQuery(expr string, raw bool) (result *Query, err error) {
+	parsed, err := gojq.Parse(expr)
+	if err != nil {
+		err = fmt.Errorf("can't parse jq expression '%s': %w", expr, err)
+		return
+	}
+	code, err := gojq.Compile(parsed)
+	if err != nil {
+		err = fmt.Errorf("can't compile jq expression '%s': %w", expr, err)
+		return
+	}
+	result = &Query{
+		code: code,
+		raw:  raw,
+	}
+	return
+}
+
+// Pretty applies the query to the given JSON document and dumps each result like the Pretty
+// function.
+func (q *Query) Pretty(stream io.Writer, body []byte) error {
+	return q.dump(stream, body, Pretty)
+}
+
+// Single applies the query to the given JSON document and dumps each result like the Single
+// function, in a single line.
+func (q *Query) Single(stream io.Writer, body []byte) error {
+	return q.dump(stream, body, Single)
+}
+
+func (q *Query) dump(stream io.Writer, body []byte, dumper func(io.Writer, []byte) error) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	// Parse the document preserving the precision of numbers, as identifiers and sizes may be
+	// larger than what can be represented with floating point numbers:
+	var input any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&input)
+	if err != nil {
+		return fmt.Errorf("can't parse JSON document: %w", err)
+	}
+
+	// Dump the results:
+	iter := q.code.Run(input)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := value.(error); ok {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("can't evaluate jq expression: %w", err)
+		}
+		if text, ok := value.(string); ok && q.raw {
+			_, err = fmt.Fprintln(stream, text)
+		} else {
+			var data []byte
+			data, err = gojq.Marshal(value)
+			if err == nil {
+				err = dumper(stream, data)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
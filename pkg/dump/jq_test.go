@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dump
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Query", func() {
+	body := []byte(`{
+		"kind": "ClusterList",
+		"items": [
+			{ "id": "123", "name": "my_cluster", "size": 123456789123456789 },
+			{ "id": "456", "name": "your_cluster", "size": 1 }
+		]
+	}`)
+
+	It("Writes each result in a separate line", func() {
+		query, err := ParseQuery(".items[].id", false)
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		err = query.Single(buffer, body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("\"123\"\n\"456\"\n"))
+	})
+
+	It("Writes strings without quotes in raw mode", func() {
+		query, err := ParseQuery(".items[].name", true)
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		err = query.Pretty(buffer, body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("my_cluster\nyour_cluster\n"))
+	})
+
+	It("Writes objects as JSON in raw mode", func() {
+		query, err := ParseQuery(".items[0] | {id}", true)
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		err = query.Pretty(buffer, body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`{ "id": "123" }`))
+	})
+
+	It("Preserves long integers", func() {
+		query, err := ParseQuery(".items[0].size", false)
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		err = query.Single(buffer, body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("123456789123456789\n"))
+	})
+
+	It("Writes nothing for an empty body", func() {
+		query, err := ParseQuery(".", false)
+		Expect(err).ToNot(HaveOccurred())
+		buffer := &bytes.Buffer{}
+		err = query.Pretty(buffer, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.Len()).To(BeZero())
+	})
+
+	It("Rejects an invalid expression", func() {
+		_, err := ParseQuery(".items[", false)
+		Expect(err).To(MatchError(ContainSubstring("can't parse jq expression")))
+	})
+
+	It("Reports evaluation errors", func() {
+		query, err := ParseQuery(".kind | keys", false)
+		Expect(err).ToNot(HaveOccurred())
+		err = query.Pretty(&bytes.Buffer{}, body)
+		Expect(err).To(MatchError(ContainSubstring("can't evaluate jq expression")))
+	})
+})
@@ -0,0 +1,361 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the OAuth authorization code flow for native
+// applications, as described in RFC 8252. The authorization server redirects the browser to a
+// temporary HTTP server listening in a random port of the loopback interface, and the code is
+// protected with PKCE, as described in RFC 7636.
+
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/browser"
+	"golang.org/x/oauth2"
+)
+
+// CallbackPath is the path of the redirect URL handled by the loopback server.
+const CallbackPath = "/oauth/callback"
+
+// DefaultTimeout is the default time that the flow waits for the user to complete the login in the
+// browser.
+const DefaultTimeout = 5 * time.Minute
+
+// AuthCodeFlowBuilder contains the data and logic needed to create an authorization code flow. Don't
+// create instances of this type directly, use the NewAuthCodeFlow function instead.
+type AuthCodeFlowBuilder struct {
+	clientID string
+	authURL  string
+	tokenURL string
+	scopes   []string
+	insecure bool
+	browser  bool
+	open     func(string) error
+	timeout  time.Duration
+	writer   io.Writer
+}
+
+// AuthCodeFlow is an authorization code flow. Don't create instances of this type directly, use the
+// NewAuthCodeFlow function instead.
+type AuthCodeFlow struct {
+	config   *oauth2.Config
+	client   *http.Client
+	browser  bool
+	open     func(string) error
+	timeout  time.Duration
+	writer   io.Writer
+	listener net.Listener
+	verifier string
+	state    string
+	results  chan authCodeResult
+}
+
+// authCodeResult is the result of the callback, sent from the handler to the goroutine that waits
+// for it.
+type authCodeResult struct {
+	token *oauth2.Token
+	err   error
+}
+
+// NewAuthCodeFlow creates a builder that can then be used to configure and create an authorization
+// code flow.
+func NewAuthCodeFlow() *AuthCodeFlowBuilder {
+	return &AuthCodeFlowBuilder{
+		scopes:  []string{"openid"},
+		browser: true,
+		open:    browser.OpenURL,
+		timeout: DefaultTimeout,
+		writer:  os.Stderr,
+	}
+}
+
+// ClientID sets the OAuth client identifier. This is mandatory.
+func (b *AuthCodeFlowBuilder) ClientID(value string) *AuthCodeFlowBuilder {
+	b.clientID = value
+	return b
+}
+
+// AuthURL sets the URL of the authorization endpoint. This is optional, the default is to use the
+// token URL replacing the trailing `token` with `auth`, which is the convention used by Keycloak
+// and Red Hat SSO.
+func (b *AuthCodeFlowBuilder) AuthURL(value string) *AuthCodeFlowBuilder {
+	b.authURL = value
+	return b
+}
+
+// TokenURL sets the URL of the token endpoint. This is mandatory.
+func (b *AuthCodeFlowBuilder) TokenURL(value string) *AuthCodeFlowBuilder {
+	b.tokenURL = value
+	return b
+}
+
+// Scopes sets the scopes requested. The default is `openid`.
+func (b *AuthCodeFlowBuilder) Scopes(values ...string) *AuthCodeFlowBuilder {
+	b.scopes = values
+	return b
+}
+
+// Insecure disables the verification of the TLS certificates of the token endpoint.
+func (b *AuthCodeFlowBuilder) Insecure(value bool) *AuthCodeFlowBuilder {
+	b.insecure = value
+	return b
+}
+
+// Browser indicates if the authorization URL should be opened in a browser. When set to false, or
+// when the browser can't be opened, the URL is written so that the user can open it manually. The
+// default is true.
+func (b *AuthCodeFlowBuilder) Browser(value bool) *AuthCodeFlowBuilder {
+	b.browser = value
+	return b
+}
+
+// Opener sets the function used to open the authorization URL in the browser. This is intended
+// for tests, the default is to open the default browser of the system.
+func (b *AuthCodeFlowBuilder) Opener(value func(string) error) *AuthCodeFlowBuilder {
+	b.open = value
+	return b
+}
+
+// Timeout sets the maximum time to wait for the user to complete the login. The default is five
+// minutes.
+func (b *AuthCodeFlowBuilder) Timeout(value time.Duration) *AuthCodeFlowBuilder {
+	b.timeout = value
+	return b
+}
+
+// Writer sets the writer used for the messages to the user. The default is the standard error.
+func (b *AuthCodeFlowBuilder) Writer(value io.Writer) *AuthCodeFlowBuilder {
+	b.writer = value
+	return b
+}
+
+// Build uses the information stored in the builder to create the flow. This starts listening in
+// a random port of the loopback interface, so the Run method should always be called to release it.
+func (b *AuthCodeFlowBuilder) Build(ctx context.Context) (result *AuthCodeFlow, err error) {
+	// Check parameters:
+	if b.clientID == "" {
+		err = fmt.Errorf("client identifier is mandatory")
+		return
+	}
+	if b.tokenURL == "" {
+		err = fmt.Errorf("token URL is mandatory")
+		return
+	}
+	authURL := b.authURL
+	if authURL == "" {
+		if !strings.HasSuffix(b.tokenURL, "/token") {
+			err = fmt.Errorf(
+				"can't calculate the authorization URL from token URL '%s', it should "+
+					"be explicitly provided",
+				b.tokenURL,
+			)
+			return
+		}
+		authURL = strings.TrimSuffix(b.tokenURL, "/token") + "/auth"
+	}
+
+	// Generate the random state used to reject callbacks that weren't triggered by this flow:
+	state, err := randomText()
+	if err != nil {
+		return
+	}
+
+	// Listen in a random port of the loopback interface. Note that RFC 8252 recommends to use
+	// the IP address instead of `localhost`, to avoid problems with name resolution and with
+	// IPv6.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		err = fmt.Errorf("can't listen in the loopback interface: %w", err)
+		return
+	}
+	redirectURL := fmt.Sprintf("http://%s%s", listener.Addr().String(), CallbackPath)
+
+	// Create the HTTP client used to request the tokens:
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if b.insecure {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // #nosec G402
+		}
+	}
+
+	// Create and populate the object:
+	result = &AuthCodeFlow{
+		config: &oauth2.Config{
+			ClientID: b.clientID,
+			Scopes:   b.scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  authURL,
+				TokenURL: b.tokenURL,
+			},
+			RedirectURL: redirectURL,
+		},
+		client: &http.Client{
+			Transport: transport,
+		},
+		browser:  b.browser,
+		open:     b.open,
+		timeout:  b.timeout,
+		writer:   b.writer,
+		listener: listener,
+		verifier: oauth2.GenerateVerifier(),
+		state:    state,
+		results:  make(chan authCodeResult, 1),
+	}
+
+	return
+}
+
+// RedirectURL returns the redirect URL handled by the loopback server, for example
+// `http://127.0.0.1:41234/oauth/callback`.
+func (f *AuthCodeFlow) RedirectURL() string {
+	return f.config.RedirectURL
+}
+
+// Run opens the authorization URL in the browser, or writes it if the browser is disabled, and
+// waits till the authorization server redirects the browser to the loopback server. Then it
+// exchanges the authorization code for the tokens and returns them.
+func (f *AuthCodeFlow) Run(ctx context.Context) (result *oauth2.Token, err error) {
+	// Start the loopback server:
+	mux := http.NewServeMux()
+	mux.HandleFunc(CallbackPath, f.handleCallback)
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		serveErr := server.Serve(f.listener)
+		if serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			f.sendResult(nil, fmt.Errorf("loopback server failed: %w", serveErr))
+		}
+	}()
+	defer server.Close()
+
+	// Calculate the authorization URL, including the PKCE challenge, and send the user there:
+	authURL := f.config.AuthCodeURL(
+		f.state,
+		oauth2.AccessTypeOffline,
+		oauth2.S256ChallengeOption(f.verifier),
+	)
+	opened := false
+	if f.browser {
+		openErr := f.open(authURL)
+		if openErr == nil {
+			opened = true
+			fmt.Fprintf(f.writer, "Opened the login page in the browser, waiting for the login to complete\n")
+		} else {
+			fmt.Fprintf(f.writer, "Can't open the browser: %v\n", openErr)
+		}
+	}
+	if !opened {
+		fmt.Fprintf(f.writer, "Open the following URL in a browser to log in:\n\n  %s\n\n", authURL)
+	}
+
+	// Wait for the result:
+	if f.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.timeout)
+		defer cancel()
+	}
+	select {
+	case value := <-f.results:
+		result, err = value.token, value.err
+	case <-ctx.Done():
+		err = fmt.Errorf("login wasn't completed before the timeout: %w", ctx.Err())
+	}
+	return
+}
+
+// handleCallback handles the request sent by the browser when the authorization server redirects
+// it to the loopback server.
+func (f *AuthCodeFlow) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Check the state first, to reject requests that weren't triggered by this flow:
+	if query.Get("state") != f.state {
+		f.writePage(w, http.StatusBadRequest, "Login failed, the state doesn't match.")
+		f.sendResult(nil, fmt.Errorf("state returned by the authorization server doesn't match"))
+		return
+	}
+
+	// Check if the authorization server reported an error:
+	if code := query.Get("error"); code != "" {
+		description := query.Get("error_description")
+		if description == "" {
+			description = code
+		}
+		f.writePage(w, http.StatusBadRequest, "Login failed: "+description)
+		f.sendResult(nil, fmt.Errorf("authorization server returned an error: %s", description))
+		return
+	}
+
+	// Exchange the code for the tokens:
+	code := query.Get("code")
+	if code == "" {
+		f.writePage(w, http.StatusBadRequest, "Login failed, there is no authorization code.")
+		f.sendResult(nil, fmt.Errorf("authorization server didn't return a code"))
+		return
+	}
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, f.client)
+	token, err := f.config.Exchange(ctx, code, oauth2.VerifierOption(f.verifier))
+	if err != nil {
+		f.writePage(w, http.StatusInternalServerError, "Login failed, can't get the tokens.")
+		f.sendResult(nil, fmt.Errorf("can't exchange authorization code: %w", err))
+		return
+	}
+	f.writePage(w, http.StatusOK, "Login successful! Please close this window and return back to CLI.")
+	f.sendResult(token, nil)
+}
+
+// sendResult sends the result to the goroutine that waits for it. Only the first result is used,
+// the rest are discarded.
+func (f *AuthCodeFlow) sendResult(token *oauth2.Token, err error) {
+	select {
+	case f.results <- authCodeResult{token: token, err: err}:
+	default:
+	}
+}
+
+// writePage writes the simple page that is displayed in the browser when the flow finishes.
+func (f *AuthCodeFlow) writePage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<html><body><p>%s</p></body></html>\n", html.EscapeString(message))
+}
+
+// randomText generates a random text suitable for the OAuth state parameter.
+func randomText() (result string, err error) {
+	data := make([]byte, 32)
+	_, err = rand.Read(data)
+	if err != nil {
+		err = fmt.Errorf("can't generate random data: %w", err)
+		return
+	}
+	result = base64.RawURLEncoding.EncodeToString(data)
+	return
+}
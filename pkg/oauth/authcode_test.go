@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Authorization code flow", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var challenge string
+	var verifier string
+	var redirectState string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		challenge = ""
+		verifier = ""
+		redirectState = ""
+
+		// Create a stub authorization server that immediately redirects back to the loopback
+		// server, and that checks the PKCE verifier when exchanging the code:
+		mux := http.NewServeMux()
+		mux.HandleFunc("/auth", func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			Expect(query.Get("response_type")).To(Equal("code"))
+			Expect(query.Get("client_id")).To(Equal("my-client"))
+			Expect(query.Get("code_challenge_method")).To(Equal("S256"))
+			challenge = query.Get("code_challenge")
+			state := query.Get("state")
+			if redirectState != "" {
+				state = redirectState
+			}
+			redirect, err := url.Parse(query.Get("redirect_uri"))
+			Expect(err).ToNot(HaveOccurred())
+			values := url.Values{}
+			values.Set("code", "my-code")
+			values.Set("state", state)
+			redirect.RawQuery = values.Encode()
+			http.Redirect(w, r, redirect.String(), http.StatusFound)
+		})
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			Expect(r.PostForm.Get("grant_type")).To(Equal("authorization_code"))
+			Expect(r.PostForm.Get("code")).To(Equal("my-code"))
+			verifier = r.PostForm.Get("code_verifier")
+			sum := sha256.Sum256([]byte(verifier))
+			if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  "my-access",
+				"refresh_token": "my-refresh",
+				"token_type":    "Bearer",
+				"expires_in":    300,
+			})
+		})
+		server = httptest.NewServer(mux)
+		DeferCleanup(server.Close)
+	})
+
+	// browse simulates the browser, following the redirects of the authorization server.
+	browse := func(authURL string) error {
+		go func() {
+			defer GinkgoRecover()
+			response, err := http.Get(authURL) // #nosec G107
+			Expect(err).ToNot(HaveOccurred())
+			_ = response.Body.Close()
+		}()
+		return nil
+	}
+
+	It("Gets the tokens using PKCE", func() {
+		buffer := &bytes.Buffer{}
+		flow, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Opener(browse).
+			Writer(buffer).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(flow.RedirectURL()).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/oauth/callback$`))
+		token, err := flow.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.AccessToken).To(Equal("my-access"))
+		Expect(token.RefreshToken).To(Equal("my-refresh"))
+		Expect(challenge).ToNot(BeEmpty())
+		Expect(verifier).ToNot(BeEmpty())
+		Expect(buffer.String()).To(ContainSubstring("Opened the login page"))
+	})
+
+	It("Uses a different port for each flow", func() {
+		first, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(first.listener.Close)
+		second, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(second.listener.Close)
+		Expect(first.RedirectURL()).ToNot(Equal(second.RedirectURL()))
+	})
+
+	It("Prints the URL instead of opening the browser", func() {
+		buffer := &bytes.Buffer{}
+		flow, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Browser(false).
+			Opener(func(string) error {
+				Fail("Browser shouldn't be opened")
+				return nil
+			}).
+			Writer(buffer).
+			Timeout(100 * time.Millisecond).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = flow.Run(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(buffer.String()).To(ContainSubstring(server.URL + "/auth?"))
+	})
+
+	It("Rejects a callback with the wrong state", func() {
+		redirectState = "junk"
+		flow, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Opener(browse).
+			Writer(&bytes.Buffer{}).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = flow.Run(ctx)
+		Expect(err).To(MatchError(ContainSubstring("state")))
+		Expect(verifier).To(BeEmpty())
+	})
+
+	It("Fails when the login isn't completed before the timeout", func() {
+		flow, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/token").
+			Opener(func(string) error { return nil }).
+			Writer(&bytes.Buffer{}).
+			Timeout(100 * time.Millisecond).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = flow.Run(ctx)
+		Expect(err).To(MatchError(ContainSubstring("timeout")))
+	})
+
+	It("Requires the authorization URL when it can't be calculated", func() {
+		_, err := NewAuthCodeFlow().
+			ClientID("my-client").
+			TokenURL(server.URL + "/oauth2/v1/tokens").
+			Build(ctx)
+		Expect(err).To(MatchError(ContainSubstring("authorization URL")))
+	})
+})
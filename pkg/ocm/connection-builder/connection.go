@@ -15,17 +15,22 @@ package connection
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 
 	"github.com/golang/glog"
 	"github.com/openshift-online/ocm-common/pkg/deprecation"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 
+	"github.com/openshift-online/ocm-cli/pkg/audit"
+	"github.com/openshift-online/ocm-cli/pkg/cassette"
 	"github.com/openshift-online/ocm-cli/pkg/config"
 	"github.com/openshift-online/ocm-cli/pkg/debug"
 	"github.com/openshift-online/ocm-cli/pkg/info"
 	"github.com/openshift-online/ocm-cli/pkg/opaquetoken"
+	"github.com/openshift-online/ocm-cli/pkg/retry"
 )
 
 // ConnectionBuilder contains the information and logic needed to build a connection to OCM. Don't
@@ -45,6 +50,10 @@ type ConnectionBuilder struct {
 	// agent is the UserAgent for a given CLI.
 	// defaults to OCM_CLI+version
 	agent string
+
+	// transportWrappers are additional wrappers for the transport of the connection. They are
+	// applied after the authentication, so they see the complete requests.
+	transportWrappers []sdk.TransportWrapper
 }
 
 // NewConnection creates a builder that can then be used to configure and build an OCM connection.
@@ -77,6 +86,13 @@ func (b *ConnectionBuilder) AsAgent(agent string) *ConnectionBuilder {
 	return b
 }
 
+// TransportWrapper adds a wrapper for the transport of the connection, for example to capture the
+// requests instead of sending them.
+func (b *ConnectionBuilder) TransportWrapper(value sdk.TransportWrapper) *ConnectionBuilder {
+	b.transportWrappers = append(b.transportWrappers, value)
+	return b
+}
+
 // Build uses the information stored in the builder to create a new OCM connection.
 func (b *ConnectionBuilder) Build() (result *sdk.Connection, err error) {
 	if b.cfg == nil {
@@ -105,6 +121,32 @@ func (b *ConnectionBuilder) Build() (result *sdk.Connection, err error) {
 
 	builder := b.initConnectionBuilderFromConfig(opaqueMode)
 
+	// Retry the requests that fail because of transient problems. This replaces the retries of
+	// the SDK, which don't honour the 'Retry-After' header. It needs to be outside of the
+	// recording wrapper so that each attempt is recorded:
+	builder.RetryLimit(0)
+	builder.TransportWrapper(b.getRetryWrapper())
+
+	// Record or replay the HTTP traffic if requested with the '--record' or '--replay' flags:
+	wrapper, err := b.getCassetteWrapper()
+	if err != nil {
+		return
+	}
+	if wrapper != nil {
+		builder.TransportWrapper(wrapper)
+	}
+
+	// Write the audit log if enabled in the configuration. This needs to be the innermost wrapper
+	// so that requests that aren't really sent, because of '--dry-run' or '--replay', aren't
+	// recorded:
+	wrapper, err = b.getAuditWrapper()
+	if err != nil {
+		return
+	}
+	if wrapper != nil {
+		builder.TransportWrapper(wrapper)
+	}
+
 	logger, err := b.getLogger()
 	if err != nil {
 		return
@@ -137,6 +179,11 @@ func (b *ConnectionBuilder) initConnectionBuilderFromConfig(opaqueMode bool) *sd
 	// Add deprecation transport wrapper to automatically handle deprecation headers
 	builder.TransportWrapper(deprecation.NewTransportWrapper())
 
+	// Add the additional transport wrappers:
+	for _, wrapper := range b.transportWrappers {
+		builder.TransportWrapper(wrapper)
+	}
+
 	// Prepare the builder for the connection adding only the properties that have explicit
 	// values in the configuration, so that default values won't be overridden:
 	if b.cfg.TokenURL != "" {
@@ -203,6 +250,57 @@ func (b *ConnectionBuilder) getLogger() (logging.Logger, error) {
 		Build()
 }
 
+// Returns the transport wrapper that records or replays the HTTP traffic, or nil if neither the
+// '--record' nor the '--replay' flags have been used
+func (b *ConnectionBuilder) getCassetteWrapper() (result sdk.TransportWrapper, err error) {
+	recordFile := cassette.RecordFile()
+	replayFile := cassette.ReplayFile()
+	if recordFile == "" && replayFile == "" {
+		return
+	}
+	if recordFile != "" && replayFile != "" {
+		err = fmt.Errorf("Options '--record' and '--replay' can't be used together")
+		return
+	}
+	tokenURL := b.getTokenURL()
+	if recordFile != "" {
+		return cassette.Recorder(recordFile, tokenURL)
+	}
+	return cassette.Replayer(replayFile, tokenURL)
+}
+
+// Returns the transport wrapper that retries failed requests as configured with the '--retries'
+// and '--timeout' flags, reporting the retries to the standard error stream in debug mode
+func (b *ConnectionBuilder) getRetryWrapper() sdk.TransportWrapper {
+	var writer io.Writer
+	if debug.Enabled() {
+		writer = os.Stderr
+	}
+	return retry.Wrapper(retry.Limit(), retry.Timeout(), writer)
+}
+
+// Returns the transport wrapper that writes the audit log, or nil if the 'audit_log' setting of
+// the configuration is empty
+func (b *ConnectionBuilder) getAuditWrapper() (result sdk.TransportWrapper, err error) {
+	if b.cfg.AuditLog == "" {
+		return
+	}
+	file, err := audit.ExpandFile(b.cfg.AuditLog)
+	if err != nil {
+		return
+	}
+	return audit.Wrapper(file, b.getTokenURL(), os.Args)
+}
+
+// Returns the token URL from the configuration, or the default of the SDK if there is none
+// configured
+func (b *ConnectionBuilder) getTokenURL() string {
+	if b.cfg.TokenURL != "" {
+		return b.cfg.TokenURL
+	}
+	return sdk.DefaultTokenURL
+}
+
 // Returns the configured agent or a default value if there is none configured
 func (b *ConnectionBuilder) getAgent() string {
 	if b.agent != "" {
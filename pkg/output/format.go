@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that writes tables in formats other than fixed width text, like
+// JSON, YAML or CSV.
+
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gitlab.com/c0b/go-ordered-json"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the name of an output format.
+type Format string
+
+const (
+	// FormatTable writes rows as fixed width text, trimming values that don't fit in the
+	// width of the column. This is the default.
+	FormatTable Format = "table"
+
+	// FormatWide writes rows as fixed width text, but never trims values. Note that this
+	// means that all the rows need to be loaded in memory before writing the first one.
+	FormatWide Format = "wide"
+
+	// FormatJSON writes rows as a JSON array containing one object per row.
+	FormatJSON Format = "json"
+
+	// FormatYAML writes rows as a YAML sequence containing one mapping per row.
+	FormatYAML Format = "yaml"
+
+	// FormatCSV writes rows as comma separated values, using the column headers for the
+	// first record.
+	FormatCSV Format = "csv"
+
+	// FormatGoTemplate writes objects using the Go template given after the equals sign, for
+	// example `go-template={{ .Name }}`.
+	FormatGoTemplate Format = "go-template"
+
+	// FormatGoTemplateFile is like FormatGoTemplate, but the template is loaded from the file
+	// given after the equals sign.
+	FormatGoTemplateFile Format = "go-template-file"
+
+	// FormatJSONPath writes objects using the JSONPath expression given after the equals sign,
+	// for example `jsonpath={.id}`.
+	FormatJSONPath Format = "jsonpath"
+
+	// FormatJSONPathFile is like FormatJSONPath, but the expression is loaded from the file given
+	// after the equals sign.
+	FormatJSONPathFile Format = "jsonpath-file"
+)
+
+// Formats returns the names of the supported output formats.
+func Formats() []string {
+	return []string{
+		string(FormatTable),
+		string(FormatWide),
+		string(FormatJSON),
+		string(FormatYAML),
+		string(FormatCSV),
+		string(FormatGoTemplate),
+		string(FormatGoTemplateFile),
+		string(FormatJSONPath),
+		string(FormatJSONPathFile),
+	}
+}
+
+// ParseFormat checks that the given text is the name of a supported format. The empty string is
+// accepted and translated into the default table format. Template formats must be followed by an
+// equals sign and the template, for example `jsonpath={.id}`, but only the name of the format is
+// returned. Use the NewTemplate method of the printer to parse the template itself.
+func ParseFormat(text string) (result Format, err error) {
+	name, argument, hasArgument := splitFormat(text)
+	if name == "" && !hasArgument {
+		result = FormatTable
+		return
+	}
+	for _, candidate := range Formats() {
+		if name == candidate {
+			result = Format(candidate)
+			break
+		}
+	}
+	if result == "" {
+		err = fmt.Errorf(
+			"unknown output format '%s', valid formats are %s",
+			name, strings.Join(Formats(), ", "),
+		)
+		return
+	}
+	if result.Template() && argument == "" {
+		err = fmt.Errorf(
+			"output format '%s' requires a template, for example '%s=...'",
+			result, result,
+		)
+		return
+	}
+	if !result.Template() && hasArgument {
+		err = fmt.Errorf("output format '%s' doesn't accept a template", result)
+		return
+	}
+	return
+}
+
+// splitFormat splits the text of an output format into the name and the optional argument that
+// follows the equals sign. The name is converted to lower case, but the argument is returned
+// without changes because it is usually a template.
+func splitFormat(text string) (name, argument string, hasArgument bool) {
+	name, argument, hasArgument = strings.Cut(strings.TrimSpace(text), "=")
+	name = strings.ToLower(strings.TrimSpace(name))
+	return
+}
+
+// AddFormatFlag adds the '--output' flag to the given set of command line flags.
+func AddFormatFlag(fs *pflag.FlagSet, value *string) {
+	fs.StringVarP(
+		value,
+		"output",
+		"o",
+		string(FormatTable),
+		fmt.Sprintf(
+			"Output format. One of: %s. The 'json', 'yaml' and 'csv' formats contain "+
+				"the same columns that the table would display. The template formats are "+
+				"applied to each object, for example '-o jsonpath={.id}'.",
+			strings.Join(Formats(), ", "),
+		),
+	)
+}
+
+// Text returns true if the format writes fixed width text.
+func (f Format) Text() bool {
+	return f == FormatTable || f == FormatWide
+}
+
+// Structured returns true if the format writes a single document containing all the rows, like
+// JSON or YAML.
+func (f Format) Structured() bool {
+	return f == FormatJSON || f == FormatYAML
+}
+
+// Template returns true if the format renders objects using a Go template or a JSONPath
+// expression.
+func (f Format) Template() bool {
+	switch f {
+	case FormatGoTemplate, FormatGoTemplateFile, FormatJSONPath, FormatJSONPathFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// writeCSVRecord writes the given row data as a CSV record.
+func (t *Table) writeCSVRecord(rowData []string) error {
+	if t.csvWriter == nil {
+		t.csvWriter = csv.NewWriter(t.printer)
+	}
+	err := t.csvWriter.Write(rowData)
+	if err != nil {
+		return err
+	}
+	t.csvWriter.Flush()
+	return t.csvWriter.Error()
+}
+
+// appendRecord saves the given row values so that they can be written later, when all the rows
+// are available, as a JSON or YAML document.
+func (t *Table) appendRecord(rowValues []interface{}) {
+	record := make([]interface{}, len(rowValues))
+	for i, rowValue := range rowValues {
+		record[i] = recordValue(rowValue)
+	}
+	t.records = append(t.records, record)
+}
+
+// writeRecords writes all the saved records as a JSON or YAML document.
+func (t *Table) writeRecords() error {
+	switch t.format {
+	case FormatJSON:
+		return t.writeJSONRecords()
+	case FormatYAML:
+		return t.writeYAMLRecords()
+	}
+	return nil
+}
+
+func (t *Table) writeJSONRecords() error {
+	items := make([]*ordered.OrderedMap, len(t.records))
+	for i, record := range t.records {
+		item := ordered.NewOrderedMap()
+		for j, column := range t.columns {
+			item.Set(column.Name(), record[j])
+		}
+		items[i] = item
+	}
+	encoder := json.NewEncoder(t.printer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(items)
+}
+
+func (t *Table) writeYAMLRecords() error {
+	// We build the YAML nodes explicitly instead of using maps because that is the only way to
+	// preserve the order of the columns:
+	items := &yaml.Node{
+		Kind:    yaml.SequenceNode,
+		Content: make([]*yaml.Node, len(t.records)),
+	}
+	for i, record := range t.records {
+		item := &yaml.Node{
+			Kind: yaml.MappingNode,
+		}
+		for j, column := range t.columns {
+			keyNode := &yaml.Node{}
+			err := keyNode.Encode(column.Name())
+			if err != nil {
+				return err
+			}
+			valueNode := &yaml.Node{}
+			err = valueNode.Encode(record[j])
+			if err != nil {
+				return err
+			}
+			item.Content = append(item.Content, keyNode, valueNode)
+		}
+		items.Content[i] = item
+	}
+	encoder := yaml.NewEncoder(t.printer)
+	encoder.SetIndent(2)
+	err := encoder.Encode(items)
+	if err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// recordValue converts a column value into something that can be directly written to a JSON or
+// YAML document. Basic types are preserved so that, for example, numbers are written as numbers
+// and not as strings. Anything else is converted to text the same way it would be for a table.
+func recordValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.Format(time.RFC3339)
+	case fmt.Stringer:
+		return typed.String()
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Bool:
+		return reflected.Bool()
+	case reflect.String:
+		return reflected.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflected.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflected.Uint()
+	case reflect.Float32, reflect.Float64:
+		return reflected.Float()
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		if reflected.IsNil() {
+			return nil
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
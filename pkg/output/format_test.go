@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+// formatTestRow is the type of the objects written to the tables in the format tests.
+type formatTestRow struct {
+	ID    string
+	Name  string
+	Nodes int
+}
+
+var _ = Describe("Format", func() {
+	var ctx context.Context
+	var buffer *bytes.Buffer
+	var printer *Printer
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create a printer that writes to a memory buffer so that we can check the results:
+		buffer = &bytes.Buffer{}
+		printer, err = NewPrinter().
+			Writer(buffer).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := printer.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// writeTable creates a table with the given format and writes the headers and two rows.
+	writeTable := func(format string) {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id, name, nodes").
+			Format(format).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteHeaders()
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "123", Name: "my_cluster", Nodes: 3})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "456", Name: "your,cluster", Nodes: 5})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("Rejects unknown format", func() {
+		_, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("xml").
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown output format 'xml'"))
+	})
+
+	It("Writes CSV using the headers from the table description", func() {
+		writeTable("csv")
+		Expect(buffer.String()).To(Equal(
+			"ID,NAME,NODES\n" +
+				"123,my_cluster,3\n" +
+				"456,\"your,cluster\",5\n",
+		))
+	})
+
+	It("Writes JSON using the column names as keys", func() {
+		writeTable("json")
+		Expect(buffer.String()).To(MatchJSON(`[
+			{
+				"id": "123",
+				"name": "my_cluster",
+				"nodes": 3
+			},
+			{
+				"id": "456",
+				"name": "your,cluster",
+				"nodes": 5
+			}
+		]`))
+	})
+
+	It("Writes empty JSON array if there are no rows", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("json").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(MatchJSON(`[]`))
+	})
+
+	It("Writes YAML preserving the order of the columns", func() {
+		writeTable("yaml")
+		Expect(buffer.String()).To(Equal(
+			"- id: \"123\"\n" +
+				"  name: my_cluster\n" +
+				"  nodes: 3\n" +
+				"- id: \"456\"\n" +
+				"  name: your,cluster\n" +
+				"  nodes: 5\n",
+		))
+	})
+
+	It("Doesn't trim values in wide format", func() {
+		table, err := printer.NewTable().
+			Name("orgs").
+			Columns("id").
+			Format("wide").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		long := strings.Repeat("x", 40)
+		err = table.WriteHeaders()
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: long})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		lines := strings.Split(buffer.String(), "\n")
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[1]).To(Equal(long))
+	})
+})
@@ -0,0 +1,405 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the implementation of the subset of the JSONPath syntax supported by the
+// 'jsonpath' output format. It follows the syntax used by 'kubectl': expressions are enclosed in
+// braces and can be mixed with text, for example:
+//
+//	{range .items[*]}{.id}{"\t"}{.name}{"\n"}{end}
+//
+// Supported path steps are fields (`.name` or `['name']`), array indexes (`[0]` or `[-1]`) and
+// wildcards (`[*]` or `.*`). Filters, slices and recursive descent aren't supported.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/data"
+)
+
+// jsonPath is a parsed JSONPath template.
+type jsonPath struct {
+	digger *data.Digger
+	nodes  []jsonPathNode
+}
+
+// jsonPathNode is the type of the elements of a parsed template. It can be a jsonPathText, a
+// jsonPathExpr or a *jsonPathRange.
+type jsonPathNode interface{}
+
+// jsonPathText is literal text that is written as is.
+type jsonPathText string
+
+// jsonPathExpr is a path expression whose results are written separated by spaces.
+type jsonPathExpr []jsonPathStep
+
+// jsonPathRange executes the body once for each of the results of the path expression.
+type jsonPathRange struct {
+	path jsonPathExpr
+	body []jsonPathNode
+}
+
+// jsonPathStepKind is the kind of a step of a path expression.
+type jsonPathStepKind int
+
+const (
+	jsonPathField jsonPathStepKind = iota
+	jsonPathIndex
+	jsonPathWildcard
+)
+
+// jsonPathStep is one step of a path expression.
+type jsonPathStep struct {
+	kind  jsonPathStepKind
+	name  string
+	index int
+}
+
+// parseJSONPath parses the given JSONPath template. If the text doesn't contain any brace then it
+// is assumed to be a single path expression, so that `.id` is equivalent to `{.id}`.
+func parseJSONPath(digger *data.Digger, text string) (result *jsonPath, err error) {
+	if !strings.Contains(text, "{") {
+		text = "{" + text + "}"
+	}
+
+	// The top of this stack is the list of nodes where new nodes are added, and the ranges
+	// that are still open are the rest of the stack:
+	var nodes []jsonPathNode
+	var ranges []*jsonPathRange
+	var stack [][]jsonPathNode
+	for text != "" {
+		open := strings.IndexByte(text, '{')
+		if open == -1 {
+			nodes = append(nodes, jsonPathText(text))
+			break
+		}
+		if open > 0 {
+			nodes = append(nodes, jsonPathText(text[:open]))
+		}
+		close := jsonPathClose(text, open)
+		if close == -1 {
+			err = fmt.Errorf("unclosed '{' in '%s'", text)
+			return
+		}
+		inner := strings.TrimSpace(text[open+1 : close])
+		text = text[close+1:]
+		switch {
+		case inner == "end":
+			if len(ranges) == 0 {
+				err = fmt.Errorf("'{end}' without matching '{range}'")
+				return
+			}
+			last := ranges[len(ranges)-1]
+			last.body = nodes
+			ranges = ranges[:len(ranges)-1]
+			nodes = append(stack[len(stack)-1], last)
+			stack = stack[:len(stack)-1]
+		case strings.HasPrefix(inner, "range "):
+			var path jsonPathExpr
+			path, err = parseJSONPathExpr(strings.TrimPrefix(inner, "range "))
+			if err != nil {
+				return
+			}
+			ranges = append(ranges, &jsonPathRange{
+				path: path,
+			})
+			stack = append(stack, nodes)
+			nodes = nil
+		case strings.HasPrefix(inner, `"`):
+			var literal string
+			literal, err = strconv.Unquote(inner)
+			if err != nil {
+				err = fmt.Errorf("invalid string literal %s: %v", inner, err)
+				return
+			}
+			nodes = append(nodes, jsonPathText(literal))
+		case strings.HasPrefix(inner, "'"):
+			nodes = append(nodes, jsonPathText(strings.Trim(inner, "'")))
+		default:
+			var path jsonPathExpr
+			path, err = parseJSONPathExpr(inner)
+			if err != nil {
+				return
+			}
+			nodes = append(nodes, path)
+		}
+	}
+	if len(ranges) != 0 {
+		err = fmt.Errorf("'{range}' without matching '{end}'")
+		return
+	}
+
+	// Return the result:
+	result = &jsonPath{
+		digger: digger,
+		nodes:  nodes,
+	}
+	return
+}
+
+// jsonPathClose returns the position of the brace that closes the one at the given position,
+// ignoring braces inside quoted strings, or -1 if there is no such brace.
+func jsonPathClose(text string, open int) int {
+	var quote byte
+	for i := open + 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseJSONPathExpr parses a path expression like `.items[*].id`. The optional `$` and `@`
+// prefixes are accepted and ignored, as expressions are always evaluated against the current
+// object.
+func parseJSONPathExpr(text string) (result jsonPathExpr, err error) {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "$")
+	text = strings.TrimPrefix(text, "@")
+	if text == "" || text == "." {
+		return
+	}
+	if text[0] != '.' && text[0] != '[' {
+		text = "." + text
+	}
+	original := text
+	for text != "" {
+		switch text[0] {
+		case '.':
+			text = text[1:]
+			if strings.HasPrefix(text, ".") {
+				err = fmt.Errorf("recursive descent isn't supported in '%s'", original)
+				return
+			}
+			end := strings.IndexAny(text, ".[")
+			if end == -1 {
+				end = len(text)
+			}
+			name := strings.TrimSpace(text[:end])
+			text = text[end:]
+			switch name {
+			case "":
+				err = fmt.Errorf("empty field name in '%s'", original)
+				return
+			case "*":
+				result = append(result, jsonPathStep{kind: jsonPathWildcard})
+			default:
+				result = append(result, jsonPathStep{kind: jsonPathField, name: name})
+			}
+		case '[':
+			end := strings.IndexByte(text, ']')
+			if end == -1 {
+				err = fmt.Errorf("unclosed '[' in '%s'", original)
+				return
+			}
+			inner := strings.TrimSpace(text[1:end])
+			text = text[end+1:]
+			switch {
+			case inner == "*":
+				result = append(result, jsonPathStep{kind: jsonPathWildcard})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"'):
+				result = append(result, jsonPathStep{
+					kind: jsonPathField,
+					name: inner[1 : len(inner)-1],
+				})
+			default:
+				var index int
+				index, err = strconv.Atoi(inner)
+				if err != nil {
+					err = fmt.Errorf("invalid index '%s' in '%s'", inner, original)
+					return
+				}
+				result = append(result, jsonPathStep{kind: jsonPathIndex, index: index})
+			}
+		default:
+			err = fmt.Errorf("unexpected character '%c' in '%s'", text[0], original)
+			return
+		}
+	}
+	return
+}
+
+// execute writes the result of applying the template to the given object.
+func (p *jsonPath) execute(buffer *bytes.Buffer, object interface{}) error {
+	return p.executeNodes(buffer, p.nodes, object)
+}
+
+func (p *jsonPath) executeNodes(buffer *bytes.Buffer, nodes []jsonPathNode,
+	object interface{}) error {
+	for _, node := range nodes {
+		switch typed := node.(type) {
+		case jsonPathText:
+			buffer.WriteString(string(typed))
+		case jsonPathExpr:
+			values := p.evaluate(object, typed)
+			for i, value := range values {
+				if i > 0 {
+					buffer.WriteString(" ")
+				}
+				text, err := jsonPathFormat(value)
+				if err != nil {
+					return err
+				}
+				buffer.WriteString(text)
+			}
+		case *jsonPathRange:
+			values := p.evaluate(object, typed.path)
+
+			// If the expression returns a single array then iterate its elements, so that
+			// `{range .items}` is equivalent to `{range .items[*]}`:
+			if len(values) == 1 {
+				reflected := reflect.ValueOf(values[0])
+				if reflected.Kind() == reflect.Slice || reflected.Kind() == reflect.Array {
+					values = p.apply(values[0], jsonPathStep{kind: jsonPathWildcard})
+				}
+			}
+			for _, value := range values {
+				err := p.executeNodes(buffer, typed.body, value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// evaluate returns the results of applying the given path expression to the given object. Fields
+// that don't exist are silently ignored.
+func (p *jsonPath) evaluate(object interface{}, expr jsonPathExpr) []interface{} {
+	values := []interface{}{object}
+	for _, step := range expr {
+		var next []interface{}
+		for _, value := range values {
+			next = append(next, p.apply(value, step)...)
+		}
+		values = next
+	}
+	return values
+}
+
+// apply returns the results of applying a single step of a path expression to the given value.
+func (p *jsonPath) apply(value interface{}, step jsonPathStep) (result []interface{}) {
+	if jsonPathIsNil(value) {
+		return
+	}
+	reflected := reflect.ValueOf(value)
+	switch step.kind {
+	case jsonPathField:
+		// Maps are accessed directly so that keys containing dots, like some labels, can be
+		// used. Anything else is delegated to the digger.
+		var field interface{}
+		if reflected.Kind() == reflect.Map && reflected.Type().Key().Kind() == reflect.String {
+			item := reflected.MapIndex(reflect.ValueOf(step.name).Convert(reflected.Type().Key()))
+			if item.IsValid() {
+				field = item.Interface()
+			}
+		} else {
+			field = p.digger.Dig(value, step.name)
+		}
+		if !jsonPathIsNil(field) {
+			result = append(result, field)
+		}
+	case jsonPathIndex:
+		if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+			return
+		}
+		index := step.index
+		if index < 0 {
+			index += reflected.Len()
+		}
+		if index >= 0 && index < reflected.Len() {
+			result = append(result, reflected.Index(index).Interface())
+		}
+	case jsonPathWildcard:
+		switch reflected.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < reflected.Len(); i++ {
+				result = append(result, reflected.Index(i).Interface())
+			}
+		case reflect.Map:
+			keys := reflected.MapKeys()
+			sort.Slice(keys, func(i, j int) bool {
+				return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j])
+			})
+			for _, key := range keys {
+				result = append(result, reflected.MapIndex(key).Interface())
+			}
+		}
+	}
+	return
+}
+
+// jsonPathIsNil checks if the given value is nil or a nil pointer, map or slice.
+func jsonPathIsNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return reflected.IsNil()
+	}
+	return false
+}
+
+// jsonPathFormat converts a value to the text that is written to the output. Strings are written
+// without quotes and maps and arrays are written as JSON.
+func jsonPathFormat(value interface{}) (result string, err error) {
+	switch typed := value.(type) {
+	case string:
+		result = typed
+		return
+	case json.Number:
+		result = typed.String()
+		return
+	case time.Time:
+		result = typed.Format(time.RFC3339)
+		return
+	case fmt.Stringer:
+		result = typed.String()
+		return
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		var data []byte
+		data, err = json.Marshal(value)
+		if err != nil {
+			return
+		}
+		result = string(data)
+	default:
+		result = fmt.Sprintf("%v", value)
+	}
+	return
+}
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that loads the descriptions of tables, merging the ones embedded in
+// the binary with the ones provided by the user in the configuration directory. For example, a
+// user could create a `~/.config/ocm/tables/clusters.yaml` file like this:
+//
+//	columns:
+//	- name: name
+//	  header: CLUSTER
+//	- name: console
+//	  header: CONSOLE
+//	  path: console.url
+//	- name: nodes
+//	  header: NODES
+//	  template: '{{ dig . "nodes.compute" }}/{{ dig . "nodes.infra" }}'
+//	presets:
+//	  mine:
+//	  - id
+//	  - name
+//	  - console
+//	  - nodes
+//
+// And then use `ocm list clusters --columns preset:mine`.
+
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+// presetPrefix is the prefix used in the column specifications to select a preset.
+const presetPrefix = "preset:"
+
+// loadTableData loads the description of the table from the embedded assets and from the
+// directory of the user, and merges them. Columns and presets in the file of the user replace the
+// embedded ones with the same name.
+func (b *TableBuilder) loadTableData() (result *tableYAML, err error) {
+	result = &tableYAML{}
+
+	// Load the embedded description. Note that it is not an error if it doesn't exist, the
+	// default column descriptions will be used in that case.
+	assetPath := fmt.Sprintf("tables/%s.yaml", b.name)
+	assetData, err := assetFS.ReadFile(assetPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+	} else if err != nil {
+		return
+	} else {
+		err = yaml.Unmarshal(assetData, result)
+		if err != nil {
+			return
+		}
+	}
+
+	// Load the description provided by the user, if any:
+	dir, err := tablesDir()
+	if err != nil {
+		// Not being able to find the configuration directory isn't an error, it only means
+		// that there can't be tables provided by the user.
+		err = nil
+		return
+	}
+	userPath := filepath.Join(dir, b.name+".yaml")
+	userData, err := os.ReadFile(userPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+		return
+	}
+	if err != nil {
+		err = fmt.Errorf("can't read table file '%s': %v", userPath, err)
+		return
+	}
+	var userTable tableYAML
+	err = yaml.Unmarshal(userData, &userTable)
+	if err != nil {
+		err = fmt.Errorf("can't parse table file '%s': %v", userPath, err)
+		return
+	}
+
+	// Merge the columns, replacing only the fields that have been specified by the user:
+	for _, userColumn := range userTable.Columns {
+		var existing *columnYAML
+		if userColumn.Name != nil {
+			for _, column := range result.Columns {
+				if column.Name != nil && *column.Name == *userColumn.Name {
+					existing = column
+					break
+				}
+			}
+		}
+		if existing == nil {
+			result.Columns = append(result.Columns, userColumn)
+			continue
+		}
+		if userColumn.Header != nil {
+			existing.Header = userColumn.Header
+		}
+		if userColumn.Width != nil {
+			existing.Width = userColumn.Width
+		}
+		if userColumn.Path != nil {
+			existing.Path = userColumn.Path
+		}
+		if userColumn.Template != nil {
+			existing.Template = userColumn.Template
+		}
+	}
+
+	// Merge the presets:
+	if len(userTable.Presets) > 0 && result.Presets == nil {
+		result.Presets = map[string][]string{}
+	}
+	for name, columns := range userTable.Presets {
+		result.Presets[name] = columns
+	}
+
+	return
+}
+
+// tablesDir returns the directory that contains the table descriptions provided by the user. That
+// is the value of the `OCM_TABLES_DIR` environment variable or, if it isn't set, the `ocm/tables`
+// subdirectory of the configuration directory of the user, usually `~/.config/ocm/tables`.
+func tablesDir() (result string, err error) {
+	result = os.Getenv(properties.TablesDirEnvKey)
+	if result != "" {
+		return
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return
+	}
+	result = filepath.Join(configDir, "ocm", "tables")
+	return
+}
+
+// expandPresets replaces the column names that have the `preset:` prefix with the names of the
+// columns of the corresponding preset.
+func (b *TableBuilder) expandPresets(tableData *tableYAML,
+	columnNames []string) (result []string, err error) {
+	result = make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		if !strings.HasPrefix(columnName, presetPrefix) {
+			result = append(result, columnName)
+			continue
+		}
+		presetName := strings.TrimSpace(strings.TrimPrefix(columnName, presetPrefix))
+		presetColumns, ok := tableData.Presets[presetName]
+		if !ok {
+			err = b.unknownPresetError(tableData, presetName)
+			return
+		}
+		for _, presetColumn := range presetColumns {
+			presetColumn = strings.TrimSpace(presetColumn)
+			if presetColumn != "" {
+				result = append(result, presetColumn)
+			}
+		}
+	}
+	return
+}
+
+func (b *TableBuilder) unknownPresetError(tableData *tableYAML, presetName string) error {
+	if len(tableData.Presets) == 0 {
+		return fmt.Errorf(
+			"table '%s' doesn't have any preset, they can be defined in file '%s.yaml' "+
+				"of the tables directory",
+			b.name, b.name,
+		)
+	}
+	presetNames := make([]string, 0, len(tableData.Presets))
+	for name := range tableData.Presets {
+		presetNames = append(presetNames, name)
+	}
+	sort.Strings(presetNames)
+	return fmt.Errorf(
+		"table '%s' doesn't have a preset named '%s', valid presets are %s",
+		b.name, presetName, strings.Join(presetNames, ", "),
+	)
+}
+
+// loadComputedValue sets the value of a column that is computed from a path or from a Go template
+// instead of using the name of the column as the path.
+func (b *TableBuilder) loadComputedValue(column *Column, columnData *columnYAML) error {
+	switch {
+	case columnData.Template != nil:
+		tmpl, err := template.New(column.name).
+			Funcs(b.printer.templateFuncs()).
+			Parse(*columnData.Template)
+		if err != nil {
+			return fmt.Errorf(
+				"can't parse template of column '%s' of table '%s': %v",
+				column.name, b.name, err,
+			)
+		}
+		// Errors are reported only once per column, as they will usually be the same for all the
+		// rows:
+		reported := &sync.Once{}
+		column.value = reflect.ValueOf(func(object interface{}) interface{} {
+			buffer := &bytes.Buffer{}
+			err := tmpl.Execute(buffer, object)
+			if err != nil {
+				reported.Do(func() {
+					fmt.Fprintf(
+						os.Stderr,
+						"Warning: can't execute template of column '%s' of table '%s': %v\n",
+						column.name, b.name, err,
+					)
+				})
+				return nil
+			}
+			return buffer.String()
+		})
+	case columnData.Path != nil:
+		path := *columnData.Path
+		column.value = reflect.ValueOf(func(object interface{}) interface{} {
+			return column.table.digger.Dig(object, path)
+		})
+	}
+	return nil
+}
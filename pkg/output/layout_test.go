@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+)
+
+var _ = Describe("Layout", func() {
+	var ctx context.Context
+	var buffer *bytes.Buffer
+	var printer *Printer
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create a temporary directory for the tables of the user:
+		dir, err = os.MkdirTemp("", "ocm-test-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.Setenv, properties.TablesDirEnvKey, os.Getenv(properties.TablesDirEnvKey))
+		err = os.Setenv(properties.TablesDirEnvKey, dir)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a printer that writes to a memory buffer so that we can check the results:
+		buffer = &bytes.Buffer{}
+		printer, err = NewPrinter().
+			Writer(buffer).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := printer.Close()
+		Expect(err).ToNot(HaveOccurred())
+		err = os.RemoveAll(dir)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// writeFile writes the table description provided by the user.
+	writeFile := func(name, content string) {
+		err := os.WriteFile(filepath.Join(dir, name+".yaml"), []byte(content), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	// writeTable creates a table with the given columns, writes one row and returns the
+	// generated text.
+	writeTable := func(columns string) string {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns(columns).
+			Format("csv").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteHeaders()
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "123", Name: "my_cluster", Nodes: 3})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		return buffer.String()
+	}
+
+	It("Uses embedded headers if there is no file", func() {
+		Expect(writeTable("id, name")).To(Equal("ID,NAME\n123,my_cluster\n"))
+	})
+
+	It("Renames headers", func() {
+		writeFile("clusters", `
+columns:
+- name: name
+  header: CLUSTER
+`)
+		Expect(writeTable("id, name")).To(Equal("ID,CLUSTER\n123,my_cluster\n"))
+	})
+
+	It("Adds computed columns", func() {
+		writeFile("clusters", `
+columns:
+- name: size
+  header: SIZE
+  path: nodes
+- name: summary
+  header: SUMMARY
+  template: '{{ .Name }}/{{ dig . "nodes" }}'
+`)
+		Expect(writeTable("id, size, summary")).To(Equal(
+			"ID,SIZE,SUMMARY\n123,3,my_cluster/3\n",
+		))
+	})
+
+	It("Selects preset", func() {
+		writeFile("clusters", `
+presets:
+  short:
+  - name
+  - id
+`)
+		Expect(writeTable("preset:short, nodes")).To(Equal(
+			"NAME,ID,NODES\nmy_cluster,123,3\n",
+		))
+	})
+
+	It("Rejects unknown preset", func() {
+		writeFile("clusters", `
+presets:
+  short:
+  - id
+  long:
+  - id
+  - name
+`)
+		_, err := printer.NewTable().
+			Name("clusters").
+			Columns("preset:junk").
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(
+			"table 'clusters' doesn't have a preset named 'junk', valid presets are long, short",
+		))
+	})
+
+	It("Rejects invalid column template", func() {
+		writeFile("clusters", `
+columns:
+- name: summary
+  template: '{{ .Name '
+`)
+		_, err := printer.NewTable().
+			Name("clusters").
+			Columns("summary").
+			Build(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can't parse template of column 'summary'"))
+	})
+
+	It("Reports template errors once per column", func() {
+		writeFile("clusters", `
+columns:
+- name: summary
+  template: '{{ .Junk }}'
+`)
+
+		// Replace the standard error so that we can check the warnings:
+		reader, writer, err := os.Pipe()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func(saved *os.File) { os.Stderr = saved }, os.Stderr)
+		os.Stderr = writer
+
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id, summary").
+			Format("csv").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "123"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "456"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Close()).To(Succeed())
+		warnings, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(buffer.String()).To(Equal("123,NONE\n456,NONE\n"))
+		lines := strings.Split(strings.TrimSpace(string(warnings)), "\n")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(HavePrefix(
+			"Warning: can't execute template of column 'summary' of table 'clusters': ",
+		))
+		Expect(lines[0]).To(ContainSubstring("Junk"))
+	})
+})
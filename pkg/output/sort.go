@@ -0,0 +1,357 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that sorts the rows of tables.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/spf13/pflag"
+)
+
+// sortKey describes one of the values used to sort the rows of a table.
+type sortKey struct {
+	// Name of the key, for example `creation_timestamp`.
+	name string
+
+	// Index of the column that contains the value of the key, or -1 if the key doesn't
+	// correspond to any of the columns of the table.
+	column int
+
+	// Indicates if the key is one of the columns described in the table asset, even if that
+	// column isn't written.
+	described bool
+}
+
+// sortRow is a row that has been saved so that it can be written later, when all the rows are
+// available and can be sorted.
+type sortRow struct {
+	object interface{}
+	values []interface{}
+	keys   []interface{}
+}
+
+// AddSortFlags adds the '--sort-by' and '--reverse' flags to the given set of command line flags.
+func AddSortFlags(fs *pflag.FlagSet, sortBy *string, reverse *bool) {
+	fs.StringVar(
+		sortBy,
+		"sort-by",
+		"",
+		"Comma separated list of columns used to sort the results, for example "+
+			"'state,creation_timestamp'. Versions, dates and numbers are compared by value. "+
+			"Note that all the results are loaded before writing the first one.",
+	)
+	fs.BoolVar(
+		reverse,
+		"reverse",
+		false,
+		"Reverse the order of the results. Only used together with '--sort-by'.",
+	)
+}
+
+// parseSortKeys parses the comma separated list of sort keys.
+func (t *Table) parseSortKeys(text string) []*sortKey {
+	var result []*sortKey
+	for _, chunk := range strings.Split(text, ",") {
+		name := strings.TrimSpace(chunk)
+		if name == "" {
+			continue
+		}
+		key := &sortKey{
+			name:   name,
+			column: -1,
+		}
+		for i, column := range t.columns {
+			if column.Name() == name {
+				key.column = i
+				break
+			}
+		}
+		for _, described := range t.described {
+			if described == name {
+				key.described = true
+				break
+			}
+		}
+		result = append(result, key)
+	}
+	return result
+}
+
+// appendSortRow saves the given row so that it can be sorted and written later. The object is
+// optional, and it is used to extract the values of keys that aren't columns of the table.
+func (t *Table) appendSortRow(object interface{}, values []interface{}) {
+	keys := make([]interface{}, len(t.sortKeys))
+	for i, key := range t.sortKeys {
+		switch {
+		case key.column >= 0:
+			keys[i] = values[key.column]
+		case object != nil:
+			keys[i] = t.digger.Dig(object, key.name)
+		}
+	}
+	t.sortRows = append(t.sortRows, &sortRow{
+		object: object,
+		values: values,
+		keys:   keys,
+	})
+}
+
+// checkSortKeys checks that the keys that aren't columns of the table are described in the table
+// asset or are top level fields of at least one of the saved objects, so that misspelled keys
+// aren't silently ignored.
+func (t *Table) checkSortKeys(rows []*sortRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	for _, key := range t.sortKeys {
+		if key.column >= 0 {
+			continue
+		}
+		field, _, _ := strings.Cut(key.name, ".")
+		found := false
+		for _, row := range rows {
+			if row.object != nil && (key.described || sortHasField(row.object, field)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf(
+				"unknown sort key '%s', valid columns are %s",
+				key.name, strings.Join(t.sortColumns(), ", "),
+			)
+		}
+	}
+	return nil
+}
+
+// sortColumns returns the names of the columns that can be used to sort the table: the columns
+// of the table and the columns described in the table asset.
+func (t *Table) sortColumns() []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, column := range t.columns {
+		if !seen[column.Name()] {
+			seen[column.Name()] = true
+			result = append(result, column.Name())
+		}
+	}
+	for _, name := range t.described {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// sortHasField checks if the given object has the given top level field. Maps need to contain the
+// key, and other objects need to have a method or public field with a matching name, like the
+// ones that the digger uses, for example 'CreationTimestamp' or 'GetCreationTimestamp' for
+// 'creation_timestamp'.
+func sortHasField(object interface{}, field string) bool {
+	value := reflect.ValueOf(object)
+	if value.Kind() == reflect.Map {
+		if value.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		return value.MapIndex(reflect.ValueOf(field).Convert(value.Type().Key())).IsValid()
+	}
+	wanted := strings.ReplaceAll(strings.ToLower(field), "_", "")
+	class := value.Type()
+	for i := 0; i < class.NumMethod(); i++ {
+		method := class.Method(i)
+		if method.Type.NumIn() != 1 || method.Type.NumOut() == 0 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimPrefix(method.Name, "Get"))
+		if name == wanted {
+			return true
+		}
+	}
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct {
+		for i := 0; i < value.NumField(); i++ {
+			structField := value.Type().Field(i)
+			if structField.IsExported() && strings.ToLower(structField.Name) == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeSortRows sorts the saved rows and writes them.
+func (t *Table) writeSortRows() error {
+	rows := t.sortRows
+	t.sortRows = nil
+	err := t.checkSortKeys(rows)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return t.lessSortRows(rows[i], rows[j])
+	})
+	for _, row := range rows {
+		if t.template != nil && row.object != nil {
+			err = t.template.Execute(row.object)
+		} else {
+			err = t.writeValues(row.values)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lessSortRows checks if the first row should be written before the second one. Rows that don't
+// have a value for a key are always written after the rows that have it, even when the order is
+// reversed.
+func (t *Table) lessSortRows(a, b *sortRow) bool {
+	for i := range t.sortKeys {
+		aKey, bKey := a.keys[i], b.keys[i]
+		aNil, bNil := sortIsNil(aKey), sortIsNil(bKey)
+		switch {
+		case aNil && bNil:
+			continue
+		case aNil:
+			return false
+		case bNil:
+			return true
+		}
+		result := compareSortValues(aKey, bKey)
+		if result == 0 {
+			continue
+		}
+		if t.reverse {
+			result = -result
+		}
+		return result < 0
+	}
+	return false
+}
+
+// compareSortValues compares two values, returning a negative number if the first is smaller, a
+// positive number if it is larger and zero if they are equal. Dates, numbers and versions are
+// compared by value when possible. Anything else is compared as text.
+func compareSortValues(a, b interface{}) int {
+	if aTime, ok := sortTime(a); ok {
+		if bTime, ok := sortTime(b); ok {
+			return aTime.Compare(bTime)
+		}
+	}
+	if aNumber, ok := sortNumber(a); ok {
+		if bNumber, ok := sortNumber(b); ok {
+			switch {
+			case aNumber < bNumber:
+				return -1
+			case aNumber > bNumber:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+	if aVersion, ok := sortVersion(a); ok {
+		if bVersion, ok := sortVersion(b); ok {
+			return aVersion.Compare(bVersion)
+		}
+	}
+	return strings.Compare(sortText(a), sortText(b))
+}
+
+// sortIsNil checks if the given value is nil or a nil pointer, map or slice.
+func sortIsNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return reflected.IsNil()
+	}
+	return false
+}
+
+// sortTime converts the value to a time if it is a time or a string in RFC3339 format.
+func sortTime(value interface{}) (result time.Time, ok bool) {
+	switch typed := value.(type) {
+	case time.Time:
+		result, ok = typed, true
+	case string:
+		parsed, err := time.Parse(time.RFC3339, typed)
+		if err == nil {
+			result, ok = parsed, true
+		}
+	}
+	return
+}
+
+// sortNumber converts the value to a number if it has a numeric type. Strings aren't converted,
+// because text that looks like a number is more often a version, and that is checked later.
+func sortNumber(value interface{}) (result float64, ok bool) {
+	if number, isNumber := value.(json.Number); isNumber {
+		parsed, err := strconv.ParseFloat(number.String(), 64)
+		if err == nil {
+			result, ok = parsed, true
+		}
+		return
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result, ok = float64(reflected.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result, ok = float64(reflected.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		result, ok = reflected.Float(), true
+	}
+	return
+}
+
+// sortVersion converts the value to a semantic version if it is text that can be parsed as
+// such. The `openshift-` prefix used in the identifiers of versions is ignored, so that for
+// example `openshift-v4.9.0` is sorted before `openshift-v4.10.0`.
+func sortVersion(value interface{}) (result *version.Version, ok bool) {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.String {
+		return
+	}
+	text := strings.TrimPrefix(reflected.String(), "openshift-")
+	parsed, err := version.NewVersion(text)
+	if err == nil {
+		result, ok = parsed, true
+	}
+	return
+}
+
+// sortText converts the value to the text that is used when it can't be compared by value.
+func sortText(value interface{}) string {
+	return fmt.Sprintf("%v", value)
+}
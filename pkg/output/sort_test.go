@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+// sortTestRow is the type of the objects written to the tables in the sort tests.
+type sortTestRow struct {
+	ID                string
+	Version           string
+	Nodes             int
+	CreationTimestamp time.Time
+}
+
+var _ = Describe("Sort", func() {
+	var ctx context.Context
+	var buffer *bytes.Buffer
+	var printer *Printer
+
+	// rows contains the objects written to the tables, intentionally in an order that is wrong
+	// for all the columns:
+	now := time.Now()
+	rows := []sortTestRow{
+		{
+			ID:                "b",
+			Version:           "openshift-v4.10.3",
+			Nodes:             10,
+			CreationTimestamp: now.Add(-1 * time.Hour),
+		},
+		{
+			ID:                "a",
+			Version:           "openshift-v4.9.12",
+			Nodes:             2,
+			CreationTimestamp: now,
+		},
+		{
+			ID:                "c",
+			Version:           "openshift-v4.10.0",
+			Nodes:             3,
+			CreationTimestamp: now.Add(-2 * time.Hour),
+		},
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create a printer that writes to a memory buffer so that we can check the results:
+		buffer = &bytes.Buffer{}
+		printer, err = NewPrinter().
+			Writer(buffer).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := printer.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// writeIDs writes the rows sorted by the given keys, using CSV to simplify checking the
+	// results, and returns the generated text.
+	writeIDs := func(sortBy string, reverse bool) string {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("csv").
+			SortBy(sortBy).
+			Reverse(reverse).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteHeaders()
+		Expect(err).ToNot(HaveOccurred())
+		for _, row := range rows {
+			err = table.WriteObject(row)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		return buffer.String()
+	}
+
+	It("Sorts text", func() {
+		Expect(writeIDs("id", false)).To(Equal("ID\na\nb\nc\n"))
+	})
+
+	It("Sorts text in reverse order", func() {
+		Expect(writeIDs("id", true)).To(Equal("ID\nc\nb\na\n"))
+	})
+
+	It("Sorts numbers by value", func() {
+		Expect(writeIDs("nodes", false)).To(Equal("ID\na\nc\nb\n"))
+	})
+
+	It("Sorts versions semantically", func() {
+		Expect(writeIDs("version", false)).To(Equal("ID\na\nc\nb\n"))
+	})
+
+	It("Sorts timestamps", func() {
+		Expect(writeIDs("creation_timestamp", false)).To(Equal("ID\nc\nb\na\n"))
+	})
+
+	It("Sorts timestamps in RFC3339 format", func() {
+		Expect(compareSortValues(
+			"2021-01-02T10:00:00Z",
+			"2021-01-10T09:00:00Z",
+		)).To(BeNumerically("<", 0))
+	})
+
+	It("Uses the following keys when the first are equal", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id, state").
+			Format("csv").
+			SortBy("state, id").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"3", "ready"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"2", "installing"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"1", "ready"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("2,installing\n1,ready\n3,ready\n"))
+	})
+
+	It("Rejects keys that aren't columns or fields", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("csv").
+			SortBy("junk").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		for _, row := range rows {
+			err = table.WriteObject(row)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		err = table.Close()
+		Expect(err).To(HaveOccurred())
+		message := err.Error()
+		Expect(message).To(HavePrefix("unknown sort key 'junk', valid columns are id, name, "))
+		Expect(message).To(ContainSubstring("openshift_version"))
+	})
+
+	It("Rejects keys that aren't columns when writing values", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("csv").
+			SortBy("state").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"1"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).To(MatchError(HavePrefix("unknown sort key 'state', valid columns are id, ")))
+	})
+
+	It("Writes rows without the key last", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id, name").
+			Format("csv").
+			SortBy("name").
+			Reverse(true).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"1", nil})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"2", "a"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteRow([]interface{}{"3", "b"})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal("3,b\n2,a\n1,NONE\n"))
+	})
+})
@@ -22,13 +22,13 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"encoding/csv"
 	"fmt"
-	"io"
+	"math"
 	"reflect"
 	"strings"
 
 	"github.com/openshift-online/ocm-sdk-go/data"
-	"gopkg.in/yaml.v3"
 )
 
 //go:embed tables
@@ -43,6 +43,9 @@ type TableBuilder struct {
 	values        map[string]reflect.Value
 	learning      bool
 	learningLimit int
+	format        string
+	sortBy        string
+	reverse       bool
 }
 
 // Table contains the data and logic needed to write tabular output.
@@ -57,11 +60,31 @@ type Table struct {
 	learning      bool
 	learningLimit int
 	learningRows  [][]string
+
+	// Format of the output, and the state needed by formats other than the fixed width text.
+	// Rows written in JSON or YAML are accumulated and written when the table is flushed.
+	format    Format
+	csvWriter *csv.Writer
+	records   [][]interface{}
+	flushed   bool
+	template  *Template
+
+	// When sorting is enabled all the rows are accumulated and written, in the right order,
+	// when the table is flushed.
+	sorting  bool
+	sortKeys []*sortKey
+	reverse  bool
+	sortRows []*sortRow
+
+	// Names of the columns described in the table asset, including the ones that aren't
+	// written, used to check the sort keys.
+	described []string
 }
 
 // tableYAML is used to load a table description from a YAML document.
 type tableYAML struct {
-	Columns []*columnYAML `yaml:"columns"`
+	Columns []*columnYAML       `yaml:"columns"`
+	Presets map[string][]string `yaml:"presets"`
 }
 
 // Column contains the data and logic needed to write columns.
@@ -90,9 +113,11 @@ type Column struct {
 
 // columnYAML is used to load a column description from a YAML document.
 type columnYAML struct {
-	Name   *string `yaml:"name"`
-	Header *string `yaml:"header"`
-	Width  *int    `yaml:"width"`
+	Name     *string `yaml:"name"`
+	Header   *string `yaml:"header"`
+	Width    *int    `yaml:"width"`
+	Path     *string `yaml:"path"`
+	Template *string `yaml:"template"`
 }
 
 // NewTable creates a new builder that can then be used to configure and create a table.
@@ -160,6 +185,30 @@ func (b *TableBuilder) LearningLimit(value int) *TableBuilder {
 	return b
 }
 
+// Format sets the output format of the table. It should be one of the names returned by the
+// Formats function. The default is to write fixed width text.
+func (b *TableBuilder) Format(value string) *TableBuilder {
+	b.format = value
+	return b
+}
+
+// SortBy sets the comma separated list of column names that will be used to sort the rows of the
+// table. Names that don't correspond to columns of the table are extracted from the row objects
+// using the digger, and names that aren't fields of any of the objects are rejected when the
+// table is flushed. Note that sorting means that all the rows need to be loaded in memory before
+// writing the first one. The default is to write the rows in the order they are given.
+func (b *TableBuilder) SortBy(value string) *TableBuilder {
+	b.sortBy = value
+	return b
+}
+
+// Reverse sets the flag that indicates if the sort order should be reversed. It has no effect if
+// the sort columns haven't been set.
+func (b *TableBuilder) Reverse(value bool) *TableBuilder {
+	b.reverse = value
+	return b
+}
+
 // Build uses the configuration stored in the builder to create a table.
 func (b *TableBuilder) Build(ctx context.Context) (result *Table, err error) {
 	// Check parameters:
@@ -175,6 +224,10 @@ func (b *TableBuilder) Build(ctx context.Context) (result *Table, err error) {
 		err = fmt.Errorf("at least one column is required")
 		return
 	}
+	format, err := ParseFormat(b.format)
+	if err != nil {
+		return
+	}
 
 	// Split the column specifications into individual column names:
 	columnNames := make([]string, 0, len(b.specs))
@@ -193,6 +246,25 @@ func (b *TableBuilder) Build(ctx context.Context) (result *Table, err error) {
 	if err != nil {
 		return
 	}
+	table.format = format
+
+	// Template formats write the objects directly, without using the columns:
+	if format.Template() {
+		table.template, err = b.printer.NewTemplate(b.format)
+		if err != nil {
+			return
+		}
+	}
+
+	// In wide mode no value should be trimmed, so we need to learn the widths of all the
+	// columns from all the rows:
+	if format == FormatWide {
+		table.learning = true
+		table.learningLimit = math.MaxInt
+		for _, column := range table.columns {
+			column.learn = true
+		}
+	}
 
 	// Create the digger if needed:
 	table.digger = b.digger
@@ -200,6 +272,11 @@ func (b *TableBuilder) Build(ctx context.Context) (result *Table, err error) {
 		table.digger = b.printer.digger
 	}
 
+	// Prepare the sort keys:
+	table.sortKeys = table.parseSortKeys(b.sortBy)
+	table.sorting = len(table.sortKeys) > 0
+	table.reverse = b.reverse
+
 	// Return the result:
 	result = table
 	return
@@ -215,34 +292,32 @@ func (b *TableBuilder) loadTable(columnNames []string) (result *Table, err error
 		learningLimit: b.learningLimit,
 	}
 
-	// Check if there is an asset corresponding to the table. If there is no asset then return
-	// the empty table description:
-	assetPath := fmt.Sprintf("tables/%s.yaml", b.name)
-	assetFile, err := assetFS.Open(assetPath)
+	// Load the description of the table, from the embedded asset and from the directory of
+	// the user:
+	tableData, err := b.loadTableData()
 	if err != nil {
-		result = table
 		return
 	}
-	defer assetFile.Close()
-	assetData, err := io.ReadAll(assetFile)
+
+	// Replace the presets with the columns that they contain:
+	columnNames, err = b.expandPresets(tableData, columnNames)
 	if err != nil {
 		return
 	}
-
-	// Parse the YAML document from the asset:
-	var tableData tableYAML
-	err = yaml.Unmarshal(assetData, &tableData)
-	if err != nil {
+	if len(columnNames) == 0 {
+		err = fmt.Errorf("at least one column is required")
 		return
 	}
 
-	// Load the descriptions of the columns from the asset:
+	// Load the descriptions of the columns:
 	columnsFromAsset := make([]*Column, len(tableData.Columns))
+	table.described = make([]string, len(tableData.Columns))
 	for i, columnData := range tableData.Columns {
 		columnsFromAsset[i], err = b.loadColumn(i, columnData)
 		if err != nil {
 			return
 		}
+		table.described[i] = columnsFromAsset[i].name
 	}
 
 	// Create the list of columns using the descriptions loaded from the asset, or else default
@@ -293,6 +368,10 @@ func (b *TableBuilder) loadColumn(i int, columnData *columnYAML) (result *Column
 	} else {
 		column.learn = true
 	}
+	err = b.loadComputedValue(column, columnData)
+	if err != nil {
+		return
+	}
 
 	// Return the column:
 	result = column
@@ -347,6 +426,29 @@ func (t *Table) WriteRow(rowValues []interface{}) error {
 		)
 	}
 
+	// If we are sorting then we need to save the row and write it later:
+	if t.sorting {
+		t.appendSortRow(nil, rowValues)
+		return nil
+	}
+
+	return t.writeValues(rowValues)
+}
+
+// writeValues writes a row of the table using the given values, without sorting.
+func (t *Table) writeValues(rowValues []interface{}) error {
+	columnCount := len(t.columns)
+
+	// Templates are applied to the row as an object where the keys are the names of the
+	// columns:
+	if t.template != nil {
+		object := make(map[string]interface{}, columnCount)
+		for i, column := range t.columns {
+			object[column.Name()] = rowValues[i]
+		}
+		return t.template.Execute(object)
+	}
+
 	// Convert the row values to strings:
 	rowData := make([]string, columnCount)
 	for i, columnValue := range rowValues {
@@ -359,6 +461,15 @@ func (t *Table) WriteRow(rowValues []interface{}) error {
 		rowData[i] = columnData
 	}
 
+	// Formats other than fixed width text don't need to learn anything:
+	switch t.format {
+	case FormatCSV:
+		return t.writeCSVRecord(rowData)
+	case FormatJSON, FormatYAML:
+		t.appendRecord(rowValues)
+		return nil
+	}
+
 	// Try to accumulate the row for learning:
 	accumulated, err := t.accumulateRow(rowData)
 	if err != nil {
@@ -481,33 +592,60 @@ func (t *Table) writeRow(rowData []string) error {
 	return err
 }
 
-// WriteHeaders writes the headers of the columns of the table.
+// WriteHeaders writes the headers of the columns of the table. Note that JSON and YAML don't have
+// headers, the names of the columns are used as the keys of the objects instead. Template formats
+// don't have headers either.
 func (t *Table) WriteHeaders() error {
+	if t.format.Structured() || t.format.Template() {
+		return nil
+	}
 	headers := make([]interface{}, len(t.columns))
 	for i, column := range t.columns {
 		headers[i] = column.Header()
 	}
-	return t.WriteRow(headers)
+	return t.writeValues(headers)
 }
 
 // WriteObject writes a row of a table extracting the values of the columns from the given object.
+// When the format is a template it is applied to the complete object instead.
 func (t *Table) WriteObject(object interface{}) error {
+	if t.template != nil && !t.sorting {
+		return t.template.Execute(object)
+	}
 	values := make([]interface{}, len(t.columns))
 	for i, column := range t.columns {
 		values[i] = column.Value(object)
 	}
+	if t.sorting {
+		t.appendSortRow(object, values)
+		return nil
+	}
 	return t.WriteRow(values)
 }
 
 // Flush makes sure that all the potentially pending data in interna buffers is written out.
 func (t *Table) Flush() error {
+	// Write the rows that were saved for sorting:
+	if t.sorting {
+		err := t.writeSortRows()
+		if err != nil {
+			return err
+		}
+	}
+
 	// Make sure to complete the learning process:
-	if t.learning {
+	if t.learning && t.format.Text() {
 		err := t.completeLearning()
 		if err != nil {
 			return err
 		}
 	}
+
+	// JSON and YAML documents can only be written once, when all the rows are available:
+	if t.format.Structured() && !t.flushed {
+		t.flushed = true
+		return t.writeRecords()
+	}
 	return nil
 }
 
@@ -516,6 +654,11 @@ func (t *Table) Close() error {
 	return t.Flush()
 }
 
+// Name returns the name of this column, for example `cloud_provider.id`.
+func (c *Column) Name() string {
+	return c.name
+}
+
 // Learn returns a flag indicating if the width of this column should be learned from the data of
 // the table.
 func (c *Column) Learn() bool {
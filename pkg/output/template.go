@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that writes objects using Go templates or JSONPath expressions.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+)
+
+// Template contains the data and logic needed to write objects using a Go template or a JSONPath
+// expression. Fields are extracted from objects using the digger of the printer, so the same
+// paths that are used in the '--columns' option can be used in templates.
+type Template struct {
+	printer    *Printer
+	format     Format
+	goTemplate *template.Template
+	jsonPath   *jsonPath
+}
+
+// NewTemplate creates a template from the given output format, which should be one of the
+// template formats, for example `go-template={{ .Name }}` or `jsonpath-file=my.jsonpath`.
+func (p *Printer) NewTemplate(spec string) (result *Template, err error) {
+	format, err := ParseFormat(spec)
+	if err != nil {
+		return
+	}
+	if !format.Template() {
+		err = fmt.Errorf("output format '%s' isn't a template format", format)
+		return
+	}
+	_, text, _ := splitFormat(spec)
+
+	// Load the text from the file if needed:
+	if format == FormatGoTemplateFile || format == FormatJSONPathFile {
+		var data []byte
+		data, err = os.ReadFile(text)
+		if err != nil {
+			err = fmt.Errorf("can't read template file '%s': %v", text, err)
+			return
+		}
+		text = string(data)
+	}
+
+	// Parse the template:
+	tmpl := &Template{
+		printer: p,
+		format:  format,
+	}
+	switch format {
+	case FormatGoTemplate, FormatGoTemplateFile:
+		tmpl.goTemplate, err = template.New("output").Funcs(p.templateFuncs()).Parse(text)
+		if err != nil {
+			err = fmt.Errorf("can't parse Go template: %v", err)
+			return
+		}
+	case FormatJSONPath, FormatJSONPathFile:
+		tmpl.jsonPath, err = parseJSONPath(p.digger, text)
+		if err != nil {
+			err = fmt.Errorf("can't parse JSONPath expression: %v", err)
+			return
+		}
+	}
+
+	// Return the result:
+	result = tmpl
+	return
+}
+
+// templateFuncs returns the functions that are available to Go templates in addition to the
+// builtin ones.
+func (p *Printer) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// The dig function extracts fields from objects using the same paths that are used
+		// in the '--columns' option, for example `{{ dig . "api.url" }}`.
+		"dig": func(object interface{}, path string) interface{} {
+			return p.digger.Dig(object, path)
+		},
+
+		// The json function converts a value to JSON text.
+		"json": func(value interface{}) (string, error) {
+			data, err := json.Marshal(value)
+			return string(data), err
+		},
+	}
+}
+
+// Execute writes the given object using the template. If the result doesn't end with a new line
+// one is added, so that the results for multiple objects are written in separate lines.
+func (t *Template) Execute(object interface{}) error {
+	buffer := &bytes.Buffer{}
+	var err error
+	if t.goTemplate != nil {
+		err = t.goTemplate.Execute(buffer, object)
+	} else {
+		err = t.jsonPath.execute(buffer, object)
+	}
+	if err != nil {
+		return fmt.Errorf("can't execute %s template: %v", t.format, err)
+	}
+	if buffer.Len() > 0 && buffer.Bytes()[buffer.Len()-1] != '\n' {
+		buffer.WriteByte('\n')
+	}
+	_, err = buffer.WriteTo(t.printer)
+	return err
+}
+
+// ExecuteJSON parses the given JSON document and writes it using the template. Numbers are
+// preserved exactly as they appear in the document.
+func (t *Template) ExecuteJSON(data []byte) error {
+	var object interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&object)
+	if err != nil {
+		return fmt.Errorf("can't parse JSON document: %v", err)
+	}
+	return t.Execute(object)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Template", func() {
+	var ctx context.Context
+	var buffer *bytes.Buffer
+	var printer *Printer
+
+	// document is the JSON document used by most of the tests:
+	const document = `{
+		"kind": "ClusterList",
+		"items": [
+			{
+				"id": "123",
+				"name": "my_cluster",
+				"api": {
+					"url": "https://api.my.com:6443"
+				},
+				"nodes": {
+					"compute": 3
+				}
+			},
+			{
+				"id": "456",
+				"name": "your_cluster",
+				"api": {
+					"url": "https://api.your.com:6443"
+				},
+				"nodes": {
+					"compute": 5
+				}
+			}
+		]
+	}`
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Create a printer that writes to a memory buffer so that we can check the results:
+		buffer = &bytes.Buffer{}
+		printer, err = NewPrinter().
+			Writer(buffer).
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := printer.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// execute creates a template from the given output format and applies it to the test
+	// document.
+	execute := func(format string) {
+		template, err := printer.NewTemplate(format)
+		Expect(err).ToNot(HaveOccurred())
+		err = template.ExecuteJSON([]byte(document))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("Rejects template format without template", func() {
+		_, err := printer.NewTemplate("jsonpath=")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires a template"))
+	})
+
+	It("Rejects template in format that isn't a template", func() {
+		_, err := printer.NewTemplate("json={.id}")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("doesn't accept a template"))
+	})
+
+	It("Writes Go template", func() {
+		execute(`go-template={{ range .items }}{{ .id }} {{ .api.url }}{{ "\n" }}{{ end }}`)
+		Expect(buffer.String()).To(Equal(
+			"123 https://api.my.com:6443\n" +
+				"456 https://api.your.com:6443\n",
+		))
+	})
+
+	It("Supports the dig function in Go templates", func() {
+		execute(`go-template={{ dig (index .items 0) "nodes.compute" }}`)
+		Expect(buffer.String()).To(Equal("3\n"))
+	})
+
+	It("Loads Go template from file", func() {
+		dir, err := os.MkdirTemp("", "ocm-test-*.d")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		file := filepath.Join(dir, "my.tmpl")
+		err = os.WriteFile(file, []byte(`{{ .kind }}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		execute("go-template-file=" + file)
+		Expect(buffer.String()).To(Equal("ClusterList\n"))
+	})
+
+	It("Writes results of JSONPath wildcard separated by spaces", func() {
+		execute("jsonpath={.items[*].id}")
+		Expect(buffer.String()).To(Equal("123 456\n"))
+	})
+
+	It("Supports JSONPath ranges and literals", func() {
+		execute(`jsonpath={range .items[*]}{.name}{"\t"}{.nodes.compute}{"\n"}{end}`)
+		Expect(buffer.String()).To(Equal(
+			"my_cluster\t3\n" +
+				"your_cluster\t5\n",
+		))
+	})
+
+	It("Supports JSONPath negative index", func() {
+		execute("jsonpath={.items[-1].name}")
+		Expect(buffer.String()).To(Equal("your_cluster\n"))
+	})
+
+	It("Writes JSONPath objects as JSON", func() {
+		execute("jsonpath={.items[0].api}")
+		Expect(buffer.String()).To(MatchJSON(`{
+			"url": "https://api.my.com:6443"
+		}`))
+	})
+
+	It("Ignores missing JSONPath fields", func() {
+		execute("jsonpath={.items[0].junk}")
+		Expect(buffer.String()).To(BeEmpty())
+	})
+
+	It("Rejects JSONPath range without end", func() {
+		_, err := printer.NewTemplate("jsonpath={range .items[*]}{.id}")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("without matching '{end}'"))
+	})
+
+	It("Uses the digger to extract JSONPath fields from objects", func() {
+		table, err := printer.NewTable().
+			Name("clusters").
+			Columns("id").
+			Format("jsonpath={.name}:{.nodes}").
+			Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteHeaders()
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "123", Name: "my_cluster", Nodes: 3})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.WriteObject(formatTestRow{ID: "456", Name: "your_cluster", Nodes: 5})
+		Expect(err).ToNot(HaveOccurred())
+		err = table.Close()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buffer.String()).To(Equal(
+			"my_cluster:3\n" +
+				"your_cluster:5\n",
+		))
+	})
+})
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the code that fetches all the pages of a collection, used by the 'ocm get'
+// command with the '--all' flag and by the 'ocm list' commands.
+
+package paging
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultSize is the default number of items requested in each page.
+const DefaultSize = 100
+
+// AddFlags adds the '--page-size' and '--max-items' flags to the given set of command line flags.
+func AddFlags(fs *pflag.FlagSet, size *int, limit *int) {
+	fs.IntVar(
+		size,
+		"page-size",
+		DefaultSize,
+		"Number of items requested in each page.",
+	)
+	fs.IntVar(
+		limit,
+		"max-items",
+		0,
+		"Maximum number of items to fetch. Zero means no limit.",
+	)
+}
+
+// Loop fetches the pages of a collection till all the items have been fetched or till the maximum
+// number of items has been reached. Don't create instances of this type directly, use the NewLoop
+// function instead.
+type Loop struct {
+	size  int
+	limit int
+	count int
+}
+
+// FetchFunc is the function that the loop calls to fetch and process a page. It receives the
+// index of the page, starting with one, and the number of items requested. It should return the
+// number of items of the page and the total number of items of the collection, or zero if it isn't
+// known. Items should only be processed if the Accept method of the loop returns true.
+type FetchFunc func(page, size int) (count, total int, err error)
+
+// NewLoop creates a loop that requests pages of the given size, and that stops after processing the
+// given maximum number of items. A size less than one means the default size, and a limit less than
+// one means that there is no limit.
+func NewLoop(size, limit int) *Loop {
+	if size < 1 {
+		size = DefaultSize
+	}
+	if limit < 0 {
+		limit = 0
+	}
+
+	// There is no point in requesting more items than will be processed:
+	if limit > 0 && limit < size {
+		size = limit
+	}
+
+	return &Loop{
+		size:  size,
+		limit: limit,
+	}
+}
+
+// Run calls the given function to fetch the pages, till the total number of items has been
+// fetched, or till the limit is reached. When the total isn't known it stops when a page has less
+// items than requested. Note that the server may return less items than requested even if there
+// are more pages, for example when it has a lower maximum page size.
+func (l *Loop) Run(fetch FetchFunc) error {
+	fetched := 0
+	for page := 1; ; page++ {
+		count, total, err := fetch(page, l.size)
+		if err != nil {
+			return err
+		}
+		fetched += count
+		if count == 0 || l.Done() {
+			return nil
+		}
+		if total > 0 {
+			if fetched >= total {
+				return nil
+			}
+			continue
+		}
+		if count < l.size {
+			return nil
+		}
+	}
+}
+
+// Accept checks if the limit has been reached. If it hasn't, it returns true and counts the item,
+// so it should be called once before processing each item.
+func (l *Loop) Accept() bool {
+	if l.Done() {
+		return false
+	}
+	l.count++
+	return true
+}
+
+// Done returns true if the limit has been reached.
+func (l *Loop) Done() bool {
+	return l.limit > 0 && l.count >= l.limit
+}
+
+// Count returns the number of items accepted so far.
+func (l *Loop) Count() int {
+	return l.count
+}
+
+// Page is the generic representation of a page of a collection, as returned by the API.
+type Page struct {
+	Kind  string            `json:"kind,omitempty"`
+	Page  int               `json:"page"`
+	Size  int               `json:"size"`
+	Total int               `json:"total"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// ParsePage parses the given response body as a page of a collection. It returns an error if the
+// body doesn't look like a page, for example if it is a single object.
+func ParsePage(data []byte) (result *Page, err error) {
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		err = fmt.Errorf("can't parse page: %w", err)
+		return
+	}
+	_, hasItems := fields["items"]
+	_, hasPage := fields["page"]
+	if !hasItems && !hasPage {
+		err = fmt.Errorf("response isn't a page of a collection, it doesn't contain 'items' or 'page'")
+		return
+	}
+	result = &Page{}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		err = fmt.Errorf("can't parse page: %w", err)
+		result = nil
+	}
+	return
+}
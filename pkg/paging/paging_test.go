@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package paging
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Loop", func() {
+	// fetcher returns a fetch function that simulates a collection with the given number of
+	// items, and that records the pages requested and the items accepted.
+	fetcher := func(loop *Loop, items int, total bool, pages *[]int, accepted *int) FetchFunc {
+		return func(page, size int) (count, result int, err error) {
+			*pages = append(*pages, page)
+			first := (page - 1) * size
+			for i := first; i < first+size && i < items; i++ {
+				count++
+				if loop.Accept() {
+					*accepted++
+				}
+			}
+			if total {
+				result = items
+			}
+			return
+		}
+	}
+
+	It("Stops when a page has less items than requested", func() {
+		var pages []int
+		var accepted int
+		loop := NewLoop(10, 0)
+		err := loop.Run(fetcher(loop, 25, false, &pages, &accepted))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2, 3}))
+		Expect(accepted).To(Equal(25))
+		Expect(loop.Count()).To(Equal(25))
+	})
+
+	It("Stops when the total has been fetched", func() {
+		var pages []int
+		var accepted int
+		loop := NewLoop(10, 0)
+		err := loop.Run(fetcher(loop, 20, true, &pages, &accepted))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2}))
+		Expect(accepted).To(Equal(20))
+	})
+
+	It("Continues when the server returns less items than requested but the total is larger", func() {
+		var pages []int
+		var accepted int
+		loop := NewLoop(500, 0)
+		err := loop.Run(func(page, size int) (count, total int, err error) {
+			// The server caps the page size to 100 items:
+			pages = append(pages, page)
+			first := (page - 1) * 100
+			for i := first; i < first+100 && i < 250; i++ {
+				count++
+				if loop.Accept() {
+					accepted++
+				}
+			}
+			return count, 250, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2, 3}))
+		Expect(accepted).To(Equal(250))
+	})
+
+	It("Fetches an extra empty page when the total isn't known", func() {
+		var pages []int
+		var accepted int
+		loop := NewLoop(10, 0)
+		err := loop.Run(fetcher(loop, 20, false, &pages, &accepted))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2, 3}))
+		Expect(accepted).To(Equal(20))
+	})
+
+	It("Stops when the limit is reached", func() {
+		var pages []int
+		var accepted int
+		loop := NewLoop(10, 15)
+		err := loop.Run(fetcher(loop, 100, true, &pages, &accepted))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pages).To(Equal([]int{1, 2}))
+		Expect(accepted).To(Equal(15))
+		Expect(loop.Done()).To(BeTrue())
+	})
+
+	It("Reduces the page size when the limit is smaller", func() {
+		var sizes []int
+		loop := NewLoop(100, 5)
+		err := loop.Run(func(page, size int) (count, total int, err error) {
+			sizes = append(sizes, size)
+			for i := 0; i < size; i++ {
+				loop.Accept()
+			}
+			return size, 1000, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sizes).To(Equal([]int{5}))
+	})
+
+	It("Uses the default size", func() {
+		var sizes []int
+		loop := NewLoop(0, 0)
+		err := loop.Run(func(page, size int) (count, total int, err error) {
+			sizes = append(sizes, size)
+			return 0, 0, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sizes).To(Equal([]int{DefaultSize}))
+	})
+
+	It("Returns the error of the fetch function", func() {
+		loop := NewLoop(10, 0)
+		err := loop.Run(func(page, size int) (count, total int, err error) {
+			return 0, 0, errors.New("my-error")
+		})
+		Expect(err).To(MatchError("my-error"))
+	})
+})
+
+var _ = Describe("Page", func() {
+	It("Parses a page", func() {
+		page, err := ParsePage([]byte(`{
+			"kind": "ClusterList",
+			"page": 2,
+			"size": 2,
+			"total": 4,
+			"items": [
+				{ "id": "123" },
+				{ "id": "456" }
+			]
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page.Kind).To(Equal("ClusterList"))
+		Expect(page.Page).To(Equal(2))
+		Expect(page.Size).To(Equal(2))
+		Expect(page.Total).To(Equal(4))
+		Expect(page.Items).To(HaveLen(2))
+		Expect(page.Items[0]).To(MatchJSON(`{ "id": "123" }`))
+	})
+
+	It("Parses an empty page without items", func() {
+		page, err := ParsePage([]byte(`{
+			"kind": "ClusterList",
+			"page": 1,
+			"size": 0,
+			"total": 0
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page.Items).To(BeEmpty())
+	})
+
+	It("Rejects a single object", func() {
+		_, err := ParsePage([]byte(`{
+			"kind": "Cluster",
+			"id": "123"
+		}`))
+		Expect(err).To(MatchError(ContainSubstring("isn't a page")))
+	})
+})
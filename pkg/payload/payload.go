@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package payload contains the functions used to build the bodies of the requests sent by the 'post'
+// and 'patch' commands from inline field assignments and from templates.
+package payload
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// fieldRE is the regular expression used to recognize the inline field assignments. The name
+// is a dot separated path, and it is followed by `=` for string values or by `:=` for JSON values.
+var fieldRE = regexp.MustCompile(`^([A-Za-z0-9_\-]+(?:\.[A-Za-z0-9_\-]+)*)(:?=)(.*)$`)
+
+// IsField checks if the given command line argument is an inline field assignment, for example
+// `name=my_cluster` or `nodes.compute:=3`. Paths are never considered assignments, even if their
+// query contains equals signs.
+func IsField(arg string) bool {
+	return !strings.HasPrefix(arg, "/") && fieldRE.MatchString(arg)
+}
+
+// SplitFields separates the inline field assignments from the rest of the command line
+// arguments.
+func SplitFields(argv []string) (rest []string, fields []string) {
+	for _, arg := range argv {
+		if IsField(arg) {
+			fields = append(fields, arg)
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+	return
+}
+
+// Apply applies the given inline field assignments to the given JSON object, and returns the
+// result. If the base is empty the assignments are applied to an empty object. Intermediate
+// objects are created when they don't exist, so `labels.env=prod` results in
+// `{"labels":{"env":"prod"}}`.
+func Apply(base []byte, fields []string) (result []byte, err error) {
+	object := map[string]any{}
+	if len(bytes.TrimSpace(base)) > 0 {
+		err = decode(base, &object)
+		if err != nil {
+			err = fmt.Errorf("can't apply fields to body, it must be a JSON object: %w", err)
+			return
+		}
+	}
+	for _, field := range fields {
+		err = set(object, field)
+		if err != nil {
+			return
+		}
+	}
+	result, err = json.Marshal(object)
+	return
+}
+
+// set applies one inline field assignment to the given object.
+func set(object map[string]any, field string) error {
+	match := fieldRE.FindStringSubmatch(field)
+	if match == nil {
+		return fmt.Errorf(
+			"field '%s' isn't valid, it should be 'name=text' or 'name:=json'",
+			field,
+		)
+	}
+	path, operator, text := match[1], match[2], match[3]
+	var value any = text
+	if operator == ":=" {
+		err := decode([]byte(text), &value)
+		if err != nil {
+			return fmt.Errorf("value of field '%s' isn't valid JSON: %w", path, err)
+		}
+	}
+	names := strings.Split(path, ".")
+	current := object
+	for i, name := range names[:len(names)-1] {
+		next, ok := current[name]
+		if !ok || next == nil {
+			child := map[string]any{}
+			current[name] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf(
+				"can't set field '%s', '%s' isn't an object",
+				path, strings.Join(names[:i+1], "."),
+			)
+		}
+		current = child
+	}
+	current[names[len(names)-1]] = value
+	return nil
+}
+
+// decode parses the given JSON text preserving the precision of numbers.
+func decode(data []byte, value any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(value)
+	if err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after JSON value")
+	}
+	return nil
+}
+
+// Render renders the Go template contained in the given file. The template receives the values
+// given in the `name=value` assignments as fields of the dot, for example `{{ .name }}`, and it can
+// use the `env` function to get the values of environment variables and the `json` function to
+// quote values. Using a value that hasn't been assigned is an error.
+func Render(file string, assignments []string) (result []byte, err error) {
+	values := map[string]string{}
+	for _, assignment := range assignments {
+		name, value, ok := strings.Cut(assignment, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			err = fmt.Errorf("value '%s' isn't valid, it should be 'name=value'", assignment)
+			return
+		}
+		values[strings.TrimSpace(name)] = value
+	}
+	// #nosec G304
+	text, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+	tmpl, err := template.New(file).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"env":  env,
+			"json": toJSON,
+		}).
+		Parse(string(text))
+	if err != nil {
+		err = fmt.Errorf("can't parse template '%s': %w", file, err)
+		return
+	}
+	buffer := &bytes.Buffer{}
+	err = tmpl.Execute(buffer, values)
+	if err != nil {
+		err = fmt.Errorf("can't render template '%s': %w", file, err)
+		return
+	}
+	result = buffer.Bytes()
+	return
+}
+
+// env returns the value of the given environment variable, or an error if it isn't set.
+func env(name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable '%s' isn't set", name)
+	}
+	return value, nil
+}
+
+// toJSON returns the JSON representation of the given value.
+func toJSON(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package payload
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Fields", func() {
+	It("Separates the fields from the path", func() {
+		rest, fields := SplitFields([]string{
+			"/api/clusters_mgmt/v1/clusters?search=name='my'",
+			"name=my_cluster",
+			"nodes.compute:=3",
+		})
+		Expect(rest).To(Equal([]string{"/api/clusters_mgmt/v1/clusters?search=name='my'"}))
+		Expect(fields).To(Equal([]string{"name=my_cluster", "nodes.compute:=3"}))
+	})
+
+	It("Doesn't consider resource aliases and identifiers fields", func() {
+		rest, fields := SplitFields([]string{"cluster", "123"})
+		Expect(rest).To(Equal([]string{"cluster", "123"}))
+		Expect(fields).To(BeEmpty())
+	})
+
+	It("Builds nested objects", func() {
+		result, err := Apply(nil, []string{
+			"name=my_cluster",
+			"nodes.compute:=3",
+			"labels.env=prod",
+			"labels.team=my=team",
+			"managed:=true",
+			"id:=123456789123456789",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(MatchJSON(`{
+			"name": "my_cluster",
+			"nodes": { "compute": 3 },
+			"labels": { "env": "prod", "team": "my=team" },
+			"managed": true,
+			"id": 123456789123456789
+		}`))
+	})
+
+	It("Applies fields on top of an existing body", func() {
+		result, err := Apply(
+			[]byte(`{ "name": "old", "nodes": { "compute": 2, "infra": 2 } }`),
+			[]string{"name=new", "nodes.compute:=3"},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(MatchJSON(`{ "name": "new", "nodes": { "compute": 3, "infra": 2 } }`))
+	})
+
+	It("Rejects invalid JSON values", func() {
+		_, err := Apply(nil, []string{"nodes:={"})
+		Expect(err).To(MatchError(ContainSubstring("isn't valid JSON")))
+	})
+
+	It("Rejects assigning inside a value that isn't an object", func() {
+		_, err := Apply(nil, []string{"name=my_cluster", "name.first=my"})
+		Expect(err).To(MatchError(ContainSubstring("'name' isn't an object")))
+	})
+
+	It("Rejects a body that isn't an object", func() {
+		_, err := Apply([]byte(`[1, 2]`), []string{"name=my_cluster"})
+		Expect(err).To(MatchError(ContainSubstring("must be a JSON object")))
+	})
+})
+
+var _ = Describe("Template", func() {
+	var file string
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "body.tmpl")
+	})
+
+	It("Renders the values and the environment variables", func() {
+		GinkgoT().Setenv("MY_REGION", "us-east-1")
+		err := os.WriteFile(file, []byte(`{
+			"name": {{ .name | json }},
+			"region": {{ env "MY_REGION" | json }}
+		}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		result, err := Render(file, []string{"name=my \"cluster\""})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(MatchJSON(`{
+			"name": "my \"cluster\"",
+			"region": "us-east-1"
+		}`))
+	})
+
+	It("Fails if a value is missing", func() {
+		err := os.WriteFile(file, []byte(`{ "name": "{{ .name }}" }`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Render(file, nil)
+		Expect(err).To(MatchError(ContainSubstring("can't render template")))
+	})
+
+	It("Fails if an environment variable isn't set", func() {
+		err := os.WriteFile(file, []byte(`{{ env "MY_UNSET_VARIABLE" }}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Render(file, nil)
+		Expect(err).To(MatchError(ContainSubstring("MY_UNSET_VARIABLE")))
+	})
+
+	It("Rejects values without name", func() {
+		_, err := Render(file, []string{"=my_value"})
+		Expect(err).To(MatchError(ContainSubstring("isn't valid")))
+	})
+})
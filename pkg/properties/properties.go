@@ -20,4 +20,10 @@ const (
 	KeyringEnvKey     = "OCM_KEYRING"
 	OpaqueTokenEnvKey = "OCM_OPAQUE_TOKEN" // #nosec G101
 	URLEnvKey         = "OCM_URL"
+	TablesDirEnvKey   = "OCM_TABLES_DIR"
+	ProfileEnvKey     = "OCM_PROFILE"
+
+	// ConfigEnvPrefix is the prefix of the environment variables that override the settings of
+	// the configuration, for example OCM_CLIENT_ID overrides the 'client_id' setting.
+	ConfigEnvPrefix = "OCM_"
 )
@@ -0,0 +1,299 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package retry contains the transport wrapper that retries the requests sent to the API when
+// they fail because of transient problems, like rate limiting or network errors, and the
+// '--retries' and '--timeout' command line flags that control it.
+package retry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultLimit is the number of times that a failed request is retried by default.
+const DefaultLimit = 3
+
+// AddFlags adds the '--retries' and '--timeout' flags to the given set of command line flags.
+func AddFlags(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&limit,
+		"retries",
+		DefaultLimit,
+		"Number of times that requests are retried when they fail because of rate limiting, "+
+			"an unavailable server or a network error. Use zero to disable retries.",
+	)
+	flags.DurationVar(
+		&timeout,
+		"timeout",
+		0,
+		"Maximum time for each request to the API, including the retries, for example "+
+			"'30s' or '5m'. The default is to wait indefinitely.",
+	)
+}
+
+// Limit returns the number of times that failed requests should be retried.
+func Limit() int {
+	return limit
+}
+
+// Timeout returns the maximum time for each request, or zero if there is no limit.
+func Timeout() time.Duration {
+	return timeout
+}
+
+var (
+	limit   = DefaultLimit
+	timeout time.Duration
+)
+
+// These are variables instead of constants so that tests can make them shorter:
+var (
+	// interval is the time to wait before the first retry. It is doubled for each additional
+	// retry, up to maxInterval.
+	interval    = 1 * time.Second
+	maxInterval = 30 * time.Second
+
+	// maxRetryAfter is the longest wait requested by a 'Retry-After' header that is honoured.
+	// Responses asking to wait longer are returned to the caller instead.
+	maxRetryAfter = 2 * time.Minute
+)
+
+// jitter is the fraction of the interval that is randomly added or subtracted, so that clients
+// that failed at the same time don't retry at the same time.
+const jitter = 0.2
+
+// Wrapper returns a transport wrapper that retries failed requests up to the given number of
+// times, and that fails requests that take longer than the given timeout, if it isn't zero.
+// Requests rejected with status 429, or with status 503 and a 'Retry-After' header, are retried
+// regardless of the method, because the server didn't process them, waiting the time requested by
+// that header if present. Network errors and the other 5xx status codes handled are only retried
+// for idempotent methods, as the server may have processed the request. If the writer isn't nil a
+// message describing each retry is written to it.
+func Wrapper(limit int, timeout time.Duration, writer io.Writer) func(http.RoundTripper) http.RoundTripper {
+	return func(wrapped http.RoundTripper) http.RoundTripper {
+		return &transport{
+			wrapped: wrapped,
+			limit:   limit,
+			timeout: timeout,
+			writer:  writer,
+		}
+	}
+}
+
+// transport is the round tripper returned by the wrapper.
+type transport struct {
+	wrapped http.RoundTripper
+	limit   int
+	timeout time.Duration
+	writer  io.Writer
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *transport) RoundTrip(request *http.Request) (response *http.Response, err error) {
+	// Apply the timeout to a copy of the request, so that the original isn't modified:
+	ctx := request.Context()
+	cancel := context.CancelFunc(func() {})
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+	}
+	request = request.WithContext(ctx)
+
+	// The body needs to be kept in memory so that it can be sent again:
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			cancel()
+			return
+		}
+		err = request.Body.Close()
+		if err != nil {
+			cancel()
+			return
+		}
+	}
+
+	attempt := 0
+	for {
+		if body != nil {
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		response, err = t.wrapped.RoundTrip(request)
+		attempt++
+		wait, reason, retry := t.check(request, response, err, attempt)
+		if !retry {
+			break
+		}
+		deadline, ok := ctx.Deadline()
+		if ok && time.Until(deadline) < wait {
+			break
+		}
+		if t.writer != nil {
+			fmt.Fprintf(
+				t.writer,
+				"Request '%s %s' %s, will retry in %s (retry %d of %d)\n",
+				request.Method, request.URL, reason, wait.Round(time.Millisecond),
+				attempt, t.limit,
+			)
+		}
+		if response != nil {
+			io.Copy(io.Discard, response.Body) // nolint
+			response.Body.Close()              // nolint
+			response = nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+		case <-timer.C:
+			continue
+		}
+		break
+	}
+
+	// Return the error, explaining if it was caused by the timeout:
+	if err != nil {
+		cancel()
+		if t.timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("request didn't complete within %s: %w", t.timeout, err)
+		} else if attempt > 1 {
+			err = fmt.Errorf("%w (after %d attempts)", err, attempt)
+		}
+		return
+	}
+
+	// The context must stay alive till the caller finishes reading the body:
+	response.Body = &cancelBody{
+		ReadCloser: response.Body,
+		cancel:     cancel,
+	}
+	return
+}
+
+// check decides if the request should be retried, and if so how long to wait before doing it.
+// The reason is a description of the failure.
+func (t *transport) check(request *http.Request, response *http.Response, err error,
+	attempt int) (wait time.Duration, reason string, retry bool) {
+	if attempt > t.limit || request.Context().Err() != nil {
+		return
+	}
+	if err != nil {
+		reason = fmt.Sprintf("failed with error: %v", err)
+		retry = Idempotent(request.Method) || isDialError(err)
+		wait = backoff(attempt)
+		return
+	}
+	code := response.StatusCode
+	reason = fmt.Sprintf("failed with status %d", code)
+	switch code {
+	case http.StatusTooManyRequests:
+		retry = true
+	case http.StatusServiceUnavailable:
+		retry = Idempotent(request.Method) || response.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		retry = Idempotent(request.Method)
+	}
+	if !retry {
+		return
+	}
+	wait, ok := retryAfter(response.Header.Get("Retry-After"), time.Now())
+	if !ok {
+		wait = backoff(attempt)
+		return
+	}
+	if wait > maxRetryAfter {
+		retry = false
+	}
+	return
+}
+
+// Idempotent checks if sending a request with the given method multiple times has the same
+// effect as sending it once, so that it is safe to retry it even if the server may have
+// processed it.
+func Idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDialError checks if the error happened while connecting to the server, so the request was
+// never sent and it is safe to retry it regardless of the method.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff calculates the time to wait before the given retry: the interval doubled for each
+// previous retry, up to the maximum, with a random jitter added or subtracted.
+func backoff(attempt int) time.Duration {
+	result := interval
+	for i := 1; i < attempt && result < maxInterval; i++ {
+		result *= 2
+	}
+	if result > maxInterval {
+		result = maxInterval
+	}
+	factor := jitter * (1 - 2*rand.Float64()) // #nosec G404
+	return result + time.Duration(float64(result)*factor)
+}
+
+// retryAfter parses the value of the 'Retry-After' header, that can be a number of seconds or an
+// HTTP date, and returns the time to wait. The flag is false if the header is empty or invalid.
+func retryAfter(value string, now time.Time) (result time.Duration, ok bool) {
+	if value == "" {
+		return
+	}
+	seconds, err := strconv.Atoi(value)
+	if err == nil && seconds >= 0 {
+		result = time.Duration(seconds) * time.Second
+		ok = true
+		return
+	}
+	date, err := http.ParseTime(value)
+	if err == nil {
+		result = max(date.Sub(now), 0)
+		ok = true
+	}
+	return
+}
+
+// cancelBody is a response body that cancels the context of the request when it is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close is the implementation of the io.Closer interface.
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,305 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+
+	"github.com/openshift-online/ocm-cli/pkg/roundtrip"
+)
+
+var _ = Describe("Retry", func() {
+	BeforeEach(func() {
+		// Make the waits short so that tests run quickly:
+		DeferCleanup(func(saved time.Duration) { interval = saved }, interval)
+		interval = time.Millisecond
+	})
+
+	// respond creates a response with the given status, headers and body.
+	respond := func(status int, header http.Header, body string) *http.Response {
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	// sequence creates a transport that returns the given results in order, and that saves the
+	// bodies of the requests that it receives.
+	type result struct {
+		response *http.Response
+		err      error
+	}
+	sequence := func(bodies *[]string, results ...result) http.RoundTripper {
+		return roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+			Expect(results).ToNot(BeEmpty(), "Unexpected request")
+			if request.Body != nil {
+				data, err := io.ReadAll(request.Body)
+				Expect(err).ToNot(HaveOccurred())
+				*bodies = append(*bodies, string(data))
+			}
+			next := results[0]
+			results = results[1:]
+			return next.response, next.err
+		})
+	}
+
+	It("Retries rate limited and unavailable requests of any method with the same body", func() {
+		var bodies []string
+		buffer := &bytes.Buffer{}
+		transport := Wrapper(3, 0, buffer)(sequence(
+			&bodies,
+			result{response: respond(http.StatusTooManyRequests, nil, "")},
+			result{response: respond(
+				http.StatusServiceUnavailable,
+				http.Header{"Retry-After": []string{"0"}},
+				"",
+			)},
+			result{response: respond(http.StatusCreated, nil, `{ "id": "123" }`)},
+		))
+		request, err := http.NewRequest(
+			http.MethodPost,
+			"https://api.example.com/api/clusters_mgmt/v1/clusters",
+			strings.NewReader(`{ "name": "my_cluster" }`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusCreated))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Body.Close()).To(Succeed())
+		Expect(string(body)).To(Equal(`{ "id": "123" }`))
+		Expect(bodies).To(HaveLen(3))
+		for _, body := range bodies {
+			Expect(body).To(Equal(`{ "name": "my_cluster" }`))
+		}
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(HavePrefix(
+			"Request 'POST https://api.example.com/api/clusters_mgmt/v1/clusters' failed with " +
+				"status 429, will retry in ",
+		))
+		Expect(lines[0]).To(HaveSuffix("(retry 1 of 3)"))
+		Expect(lines[1]).To(HaveSuffix("(retry 2 of 3)"))
+	})
+
+	It("Returns the last response when the retries are exhausted", func() {
+		var bodies []string
+		transport := Wrapper(2, 0, nil)(sequence(
+			&bodies,
+			result{response: respond(http.StatusServiceUnavailable, nil, "")},
+			result{response: respond(http.StatusServiceUnavailable, nil, "")},
+			result{response: respond(http.StatusServiceUnavailable, nil, "last")},
+		))
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("last"))
+	})
+
+	It("Doesn't retry when disabled", func() {
+		var bodies []string
+		transport := Wrapper(0, 0, nil)(sequence(
+			&bodies,
+			result{response: respond(http.StatusServiceUnavailable, nil, "")},
+		))
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+	})
+
+	It("Retries network errors only for idempotent methods", func() {
+		var bodies []string
+		transport := Wrapper(3, 0, nil)(sequence(
+			&bodies,
+			result{err: errors.New("connection reset by peer")},
+			result{response: respond(http.StatusNoContent, nil, "")},
+		))
+		request, err := http.NewRequest(http.MethodDelete, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+		transport = Wrapper(3, 0, nil)(sequence(
+			&bodies,
+			result{err: errors.New("connection reset by peer")},
+		))
+		request, err = http.NewRequest(http.MethodPatch, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = transport.RoundTrip(request)
+		Expect(err).To(MatchError("connection reset by peer"))
+	})
+
+	It("Retries connection errors for any method", func() {
+		var bodies []string
+		transport := Wrapper(3, 0, nil)(sequence(
+			&bodies,
+			result{err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}},
+			result{response: respond(http.StatusOK, nil, "")},
+		))
+		request, err := http.NewRequest(http.MethodPost, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("Doesn't retry gateway errors for methods that aren't idempotent", func() {
+		var bodies []string
+		transport := Wrapper(3, 0, nil)(sequence(
+			&bodies,
+			result{response: respond(http.StatusBadGateway, nil, "")},
+		))
+		request, err := http.NewRequest(http.MethodPost, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusBadGateway))
+	})
+
+	It("Doesn't retry service unavailable without Retry-After for methods that aren't idempotent", func() {
+		var bodies []string
+		transport := Wrapper(3, 0, nil)(sequence(
+			&bodies,
+			result{response: respond(http.StatusServiceUnavailable, nil, "")},
+		))
+		request, err := http.NewRequest(
+			http.MethodPost,
+			"https://api.example.com/api",
+			strings.NewReader(`{ "name": "my_cluster" }`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(bodies).To(HaveLen(1))
+	})
+
+	It("Honours the Retry-After header", func() {
+		var bodies []string
+		buffer := &bytes.Buffer{}
+		transport := Wrapper(3, 0, buffer)(sequence(
+			&bodies,
+			result{response: respond(
+				http.StatusTooManyRequests,
+				http.Header{"Retry-After": []string{"1"}},
+				"",
+			)},
+			result{response: respond(http.StatusOK, nil, "")},
+		))
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		start := time.Now()
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		Expect(buffer.String()).To(ContainSubstring("will retry in 1s"))
+	})
+
+	It("Doesn't wait longer than the timeout", func() {
+		var bodies []string
+		transport := Wrapper(3, 100*time.Millisecond, nil)(sequence(
+			&bodies,
+			result{response: respond(
+				http.StatusTooManyRequests,
+				http.Header{"Retry-After": []string{"60"}},
+				"",
+			)},
+		))
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		start := time.Now()
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusTooManyRequests))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("Fails requests that take longer than the timeout", func() {
+		transport := Wrapper(3, 50*time.Millisecond, nil)(
+			roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+				<-request.Context().Done()
+				return nil, request.Context().Err()
+			}),
+		)
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = transport.RoundTrip(request)
+		Expect(err).To(MatchError(ContainSubstring("request didn't complete within 50ms")))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+
+	It("Keeps the context alive till the body is closed", func() {
+		var ctx context.Context
+		transport := Wrapper(3, time.Minute, nil)(
+			roundtrip.Func(func(request *http.Request) (*http.Response, error) {
+				ctx = request.Context()
+				return respond(http.StatusOK, nil, "body"), nil
+			}),
+		)
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/api", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := transport.RoundTrip(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctx.Err()).ToNot(HaveOccurred())
+		Expect(response.Body.Close()).To(Succeed())
+		Expect(ctx.Err()).To(MatchError(context.Canceled))
+	})
+
+	DescribeTable(
+		"Retry-After parsing",
+		func(value string, expected time.Duration, valid bool) {
+			now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+			actual, ok := retryAfter(value, now)
+			Expect(ok).To(Equal(valid))
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("Empty", "", time.Duration(0), false),
+		Entry("Seconds", "120", 2*time.Minute, true),
+		Entry("Date", "Tue, 10 Mar 2026 12:00:30 GMT", 30*time.Second, true),
+		Entry("Past date", "Tue, 10 Mar 2026 11:00:00 GMT", time.Duration(0), true),
+		Entry("Junk", "soon", time.Duration(0), false),
+	)
+
+	It("Doubles the backoff up to the maximum", func() {
+		Expect(backoff(1)).To(BeNumerically("~", interval, interval/4))
+		Expect(backoff(3)).To(BeNumerically("~", 4*interval, interval))
+		Expect(backoff(100)).To(BeNumerically("<=", maxInterval+maxInterval/4))
+	})
+})
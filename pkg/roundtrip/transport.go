@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package roundtrip contains helpers shared by the wrappers that intercept the requests sent to
+// the API, like the ones used for dry runs, audit logs and cassettes.
+package roundtrip
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Func is an adapter to use an ordinary function as a round tripper.
+type Func func(*http.Request) (*http.Response, error)
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (f Func) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// IsTokenRequest checks if the given request is a request to the token URL. The query and the
+// trailing slashes are ignored. The result is always false if the token URL is empty.
+func IsTokenRequest(request *http.Request, tokenURL string) bool {
+	if tokenURL == "" {
+		return false
+	}
+	target := *request.URL
+	target.RawQuery = ""
+	target.Fragment = ""
+	return strings.TrimSuffix(target.String(), "/") == strings.TrimSuffix(tokenURL, "/")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtrip
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = DescribeTable(
+	"Token request detection",
+	func(url, tokenURL string, expected bool) {
+		request, err := http.NewRequest(http.MethodPost, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(IsTokenRequest(request, tokenURL)).To(Equal(expected))
+	},
+	Entry(
+		"Same URL",
+		"https://sso.example.com/token",
+		"https://sso.example.com/token",
+		true,
+	),
+	Entry(
+		"Query and trailing slash",
+		"https://sso.example.com/token/?grant_type=refresh_token",
+		"https://sso.example.com/token",
+		true,
+	),
+	Entry(
+		"Different URL",
+		"https://api.example.com/api/clusters_mgmt/v1/clusters",
+		"https://sso.example.com/token",
+		false,
+	),
+	Entry(
+		"No token URL",
+		"https://sso.example.com/token",
+		"",
+		false,
+	),
+)
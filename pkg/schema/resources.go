@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that discover the resources described by the specifications
+// and that complete paths.
+
+package schema
+
+import (
+	"sort"
+	"strings"
+)
+
+// Resource describes a path of a service and the methods that it supports.
+type Resource struct {
+	// Service is the name of the service, for example `clusters_mgmt`.
+	Service string
+
+	// Version is the version of the service, for example `v1`.
+	Version string
+
+	// Path is the template of the path, for example `/api/clusters_mgmt/v1/clusters/{cluster_id}`.
+	Path string
+
+	// Methods are the HTTP methods supported by the path, for example `GET` and `POST`.
+	Methods []string
+
+	// Collection is true if the GET method of the path returns a list of items.
+	Collection bool
+}
+
+// methodOrder is the order used to sort the methods, so that they appear in the usual order.
+var methodOrder = map[string]int{
+	"GET":    0,
+	"POST":   1,
+	"PUT":    2,
+	"PATCH":  3,
+	"DELETE": 4,
+}
+
+// Resources returns the resources described by the specifications, sorted by path.
+func (c *Catalog) Resources() []*Resource {
+	result := []*Resource{}
+	for _, spec := range c.specs {
+		for path, operations := range spec.Paths {
+			segments := strings.Split(path, "/")
+			resource := &Resource{
+				Path: path,
+			}
+			if len(segments) > 3 {
+				resource.Service = segments[2]
+				resource.Version = segments[3]
+			}
+			for method, operation := range operations {
+				method = strings.ToUpper(method)
+				if _, ok := methodOrder[method]; !ok {
+					continue
+				}
+				resource.Methods = append(resource.Methods, method)
+				if method == "GET" {
+					resource.Collection = c.isList(spec, operation)
+				}
+			}
+			sort.Slice(resource.Methods, func(i, j int) bool {
+				return methodOrder[resource.Methods[i]] < methodOrder[resource.Methods[j]]
+			})
+			result = append(result, resource)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+// isList checks if the given operation returns a list of items.
+func (c *Catalog) isList(spec *spec, operation *operation) bool {
+	response := operation.Responses["200"]
+	if response == nil {
+		return false
+	}
+	content, ok := response.Content["application/json"]
+	if !ok {
+		return false
+	}
+	checker := &checker{
+		schemas: spec.Components.Schemas,
+	}
+	schema := checker.resolve(content.Schema)
+	if schema == nil {
+		return false
+	}
+	items := checker.resolve(schema.Properties["items"])
+	return items != nil && items.Type == "array"
+}
+
+// CompletePath calculates the completions for the given partial path. The result contains the
+// paths of the next segments that match the text, and the paths of the collections whose
+// identifiers should be added to the completions, because the next segment of the path is the
+// identifier of an item of that collection. For example, for `/api/clusters_mgmt/v1/cl` the
+// result will contain `/api/clusters_mgmt/v1/clusters` and for
+// `/api/clusters_mgmt/v1/clusters/1` the collections will contain
+// `/api/clusters_mgmt/v1/clusters`. Paths that are a prefix of longer paths are also returned
+// with a trailing slash, so that the user can continue with the next segment.
+func (c *Catalog) CompletePath(text string) (paths, collections []string) {
+	if !strings.HasPrefix(text, "/") {
+		return
+	}
+	segments := strings.Split(text, "/")
+	last := len(segments) - 1
+	base := strings.Join(segments[:last], "/")
+	partial := segments[last]
+	pathSet := map[string]bool{}
+	collectionSet := map[string]bool{}
+	for _, spec := range c.specs {
+		for template := range spec.Paths {
+			parts := strings.Split(template, "/")
+			if len(parts) <= last {
+				continue
+			}
+			if _, ok := matchPath(parts[:last], segments[:last]); !ok {
+				continue
+			}
+			part := parts[last]
+			if isParameter(part) {
+				collectionSet[base] = true
+				continue
+			}
+			if !strings.HasPrefix(part, partial) {
+				continue
+			}
+			path := base + "/" + part
+			if len(parts) > last+1 {
+				pathSet[path+"/"] = true
+			} else {
+				pathSet[path] = true
+			}
+		}
+	}
+	paths = sortedKeys(pathSet)
+	collections = sortedKeys(collectionSet)
+	return
+}
+
+// isParameter checks if the given segment of a path template is a parameter, like
+// `{cluster_id}`.
+func isParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// sortedKeys returns the sorted keys of the given set.
+func sortedKeys(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Resources", func() {
+	var catalog *Catalog
+
+	BeforeEach(func() {
+		var err error
+		catalog, err = NewCatalog().
+			Spec([]byte(testSpec)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Lists the resources with their methods", func() {
+		Expect(catalog.Resources()).To(Equal([]*Resource{
+			{
+				Service:    "clusters_mgmt",
+				Version:    "v1",
+				Path:       "/api/clusters_mgmt/v1/clusters",
+				Methods:    []string{"GET", "POST"},
+				Collection: true,
+			},
+			{
+				Service: "clusters_mgmt",
+				Version: "v1",
+				Path:    "/api/clusters_mgmt/v1/clusters/{cluster_id}",
+				Methods: []string{"GET", "PATCH", "DELETE"},
+			},
+			{
+				Service: "clusters_mgmt",
+				Version: "v1",
+				Path:    "/api/clusters_mgmt/v1/clusters/{cluster_id}/hibernate",
+				Methods: []string{"POST"},
+			},
+		}))
+	})
+
+	It("Completes literal segments", func() {
+		paths, collections := catalog.CompletePath("/api/clusters_mgmt/v1/cl")
+		Expect(paths).To(Equal([]string{
+			"/api/clusters_mgmt/v1/clusters",
+			"/api/clusters_mgmt/v1/clusters/",
+		}))
+		Expect(collections).To(BeEmpty())
+	})
+
+	It("Completes from the root", func() {
+		paths, _ := catalog.CompletePath("/")
+		Expect(paths).To(Equal([]string{"/api/"}))
+	})
+
+	It("Returns the collection when the next segment is an identifier", func() {
+		paths, collections := catalog.CompletePath("/api/clusters_mgmt/v1/clusters/12")
+		Expect(paths).To(BeEmpty())
+		Expect(collections).To(Equal([]string{"/api/clusters_mgmt/v1/clusters"}))
+	})
+
+	It("Completes the segments after an identifier", func() {
+		paths, collections := catalog.CompletePath("/api/clusters_mgmt/v1/clusters/123/h")
+		Expect(paths).To(Equal([]string{"/api/clusters_mgmt/v1/clusters/123/hibernate"}))
+		Expect(collections).To(BeEmpty())
+	})
+
+	It("Doesn't complete relative paths", func() {
+		paths, collections := catalog.CompletePath("clusters")
+		Expect(paths).To(BeEmpty())
+		Expect(collections).To(BeEmpty())
+	})
+})
@@ -0,0 +1,446 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema contains the code that uses the OpenAPI specifications of the services to
+// validate the bodies of requests, so that mistakes like misspelled field names are detected
+// before sending the requests, and to discover the resources provided by the services.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Validation modes:
+const (
+	ModeStrict = "strict"
+	ModeWarn   = "warn"
+	ModeNone   = "none"
+)
+
+// AddValidateFlag adds the '--validate' flag to the given set of command line flags.
+func AddValidateFlag(fs *pflag.FlagSet, value *string) {
+	fs.StringVar(
+		value,
+		"validate",
+		ModeWarn,
+		"Check the request body against the specification of the service before sending it. "+
+			"With 'strict' problems are errors and the request isn't sent, with 'warn' "+
+			"problems are reported and the request is sent anyway, and with 'none' the "+
+			"body isn't checked.",
+	)
+}
+
+// CheckMode checks that the given text is a valid validation mode.
+func CheckMode(text string) error {
+	switch text {
+	case ModeStrict, ModeWarn, ModeNone:
+		return nil
+	default:
+		return fmt.Errorf(
+			"validation mode '%s' isn't valid, valid modes are '%s', '%s' and '%s'",
+			text, ModeStrict, ModeWarn, ModeNone,
+		)
+	}
+}
+
+// Problem describes a difference between a request body and the specification.
+type Problem struct {
+	// Path of the field, for example `nodes.compute` or `subnets[0]`. Empty for the root of the
+	// document.
+	Path string
+
+	// Message describing the problem.
+	Message string
+}
+
+// String returns the text representation of the problem.
+func (p Problem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
+// CatalogBuilder contains the data and logic needed to create a catalog. Don't create instances of
+// this type directly, use the NewCatalog function instead.
+type CatalogBuilder struct {
+	specs [][]byte
+}
+
+// Catalog contains the information extracted from a set of OpenAPI specifications. It is used to
+// check request bodies and to discover the resources of the services. Don't create instances of
+// this type directly, use the NewCatalog function instead.
+type Catalog struct {
+	specs []*spec
+}
+
+// spec is the subset of an OpenAPI specification needed to validate request bodies.
+type spec struct {
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*object `json:"schemas"`
+	} `json:"components"`
+}
+
+type operation struct {
+	RequestBody *content            `json:"requestBody"`
+	Responses   map[string]*content `json:"responses"`
+}
+
+type content struct {
+	Content map[string]struct {
+		Schema *object `json:"schema"`
+	} `json:"content"`
+}
+
+// object is the subset of an OpenAPI schema object used by the catalog.
+type object struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Enum                 []any              `json:"enum"`
+	Properties           map[string]*object `json:"properties"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Items                *object            `json:"items"`
+	Required             []string           `json:"required"`
+}
+
+// NewCatalog creates a builder that can then be used to configure and create a catalog.
+func NewCatalog() *CatalogBuilder {
+	return &CatalogBuilder{}
+}
+
+// Spec adds an OpenAPI specification, in JSON format, for example the `OpenAPI` variable of the
+// `clustersmgmt/v1` package of the SDK.
+func (b *CatalogBuilder) Spec(value []byte) *CatalogBuilder {
+	b.specs = append(b.specs, value)
+	return b
+}
+
+// Build uses the information stored in the builder to create the catalog.
+func (b *CatalogBuilder) Build() (result *Catalog, err error) {
+	specs := make([]*spec, len(b.specs))
+	for i, data := range b.specs {
+		specs[i] = &spec{}
+		err = json.Unmarshal(data, specs[i])
+		if err != nil {
+			err = fmt.Errorf("can't parse OpenAPI specification: %w", err)
+			return
+		}
+	}
+	result = &Catalog{
+		specs: specs,
+	}
+	return
+}
+
+// Validate checks the body of a request with the given method and path against the
+// specification of the corresponding operation. The found flag will be false if there is no
+// specification for the operation, and in that case the body isn't checked.
+func (c *Catalog) Validate(method, path string, body []byte) (problems []Problem, found bool,
+	err error) {
+	// Find the schema of the request body:
+	root, schemas := c.lookup(strings.ToLower(method), path)
+	if root == nil {
+		return
+	}
+	found = true
+
+	// Parse the body preserving numbers, so that integers can be distinguished from floating
+	// point numbers:
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err = decoder.Decode(&document)
+	if err != nil {
+		err = fmt.Errorf("can't parse request body: %w", err)
+		return
+	}
+
+	// Check the document:
+	checker := &checker{
+		schemas: schemas,
+	}
+	checker.check("", document, root)
+	problems = checker.problems
+	return
+}
+
+// lookup finds the schema of the request body of the operation that matches the given method and
+// path. When multiple paths match, the one with more literal segments is preferred, so that
+// `/clusters/{cluster_id}` doesn't hide `/clusters/search`.
+func (c *Catalog) lookup(method, path string) (result *object, schemas map[string]*object) {
+	if index := strings.IndexAny(path, "?#"); index != -1 {
+		path = path[:index]
+	}
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	best := -1
+	for _, spec := range c.specs {
+		for template, operations := range spec.Paths {
+			score, ok := matchPath(strings.Split(template, "/"), segments)
+			if !ok || score <= best {
+				continue
+			}
+			operation := operations[method]
+			if operation == nil || operation.RequestBody == nil {
+				continue
+			}
+			content, ok := operation.RequestBody.Content["application/json"]
+			if !ok || content.Schema == nil {
+				continue
+			}
+			best = score
+			result = content.Schema
+			schemas = spec.Components.Schemas
+		}
+	}
+	return
+}
+
+// matchPath checks if the given path segments match the segments of the template. It returns
+// the number of literal segments of the template.
+func matchPath(template, segments []string) (score int, ok bool) {
+	if len(template) != len(segments) {
+		return
+	}
+	for i, segment := range template {
+		if isParameter(segment) {
+			continue
+		}
+		if segment != segments[i] {
+			return
+		}
+		score++
+	}
+	ok = true
+	return
+}
+
+// checker contains the state of the validation of a document.
+type checker struct {
+	schemas  map[string]*object
+	problems []Problem
+}
+
+func (c *checker) report(path string, format string, args ...any) {
+	c.problems = append(c.problems, Problem{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// resolve follows the references of the given schema.
+func (c *checker) resolve(schema *object) *object {
+	for schema != nil && schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		schema = c.schemas[name]
+	}
+	return schema
+}
+
+// check checks the given value against the given schema, adding the problems found.
+func (c *checker) check(path string, value any, schema *object) {
+	schema = c.resolve(schema)
+	if schema == nil || value == nil {
+		return
+	}
+	kind := schema.Type
+	if kind == "" && schema.Properties != nil {
+		kind = "object"
+	}
+	switch kind {
+	case "object":
+		c.checkObject(path, value, schema)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			c.report(path, "expected an array but got %s", describe(value))
+			return
+		}
+		for i, item := range items {
+			c.check(fmt.Sprintf("%s[%d]", path, i), item, schema.Items)
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			c.report(path, "expected a string but got %s", describe(value))
+			return
+		}
+		c.checkEnum(path, text, schema)
+		if schema.Format == "date-time" {
+			_, err := time.Parse(time.RFC3339, text)
+			if err != nil {
+				c.report(path, "expected a RFC3339 date but got '%s'", text)
+			}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			c.report(path, "expected a boolean but got %s", describe(value))
+		}
+	case "integer":
+		number, ok := value.(json.Number)
+		if !ok {
+			c.report(path, "expected an integer but got %s", describe(value))
+			return
+		}
+		if _, err := number.Int64(); err != nil {
+			c.report(path, "expected an integer but got '%s'", number)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			c.report(path, "expected a number but got %s", describe(value))
+		}
+	}
+}
+
+// checkObject checks that the given value is an object, that it doesn't contain unknown fields
+// and that it contains the required fields.
+func (c *checker) checkObject(path string, value any, schema *object) {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		c.report(path, "expected an object but got %s", describe(value))
+		return
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	additional := c.additional(schema)
+	for _, name := range names {
+		field := join(path, name)
+		property, ok := schema.Properties[name]
+		switch {
+		case ok:
+			c.check(field, fields[name], property)
+		case additional != nil:
+			c.check(field, fields[name], additional)
+		case schema.Properties != nil && len(schema.AdditionalProperties) == 0:
+			c.report(field, "unknown field%s", suggest(name, schema.Properties))
+		}
+	}
+	for _, name := range schema.Required {
+		if _, ok := fields[name]; !ok {
+			c.report(join(path, name), "required field is missing")
+		}
+	}
+}
+
+// additional returns the schema of the additional properties of an object, or nil if they aren't
+// described by a schema.
+func (c *checker) additional(schema *object) *object {
+	if len(schema.AdditionalProperties) == 0 {
+		return nil
+	}
+	result := &object{}
+	err := json.Unmarshal(schema.AdditionalProperties, result)
+	if err != nil {
+		// This happens when it is a boolean instead of a schema:
+		return nil
+	}
+	return result
+}
+
+// checkEnum checks that the given text is one of the values allowed by the schema, if it has an
+// enumeration.
+func (c *checker) checkEnum(path, text string, schema *object) {
+	if len(schema.Enum) == 0 {
+		return
+	}
+	values := make([]string, len(schema.Enum))
+	for i, value := range schema.Enum {
+		values[i] = fmt.Sprint(value)
+		if values[i] == text {
+			return
+		}
+	}
+	c.report(
+		path, "value '%s' isn't valid, valid values are '%s'",
+		text, strings.Join(values, "', '"),
+	)
+}
+
+// join adds the given field name to the path.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// describe returns a short description of the type of the given value, for use in messages.
+func describe(value any) string {
+	switch typed := value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return fmt.Sprintf("string '%s'", typed)
+	case bool:
+		return fmt.Sprintf("boolean '%t'", typed)
+	case json.Number:
+		return fmt.Sprintf("number '%s'", typed)
+	default:
+		return fmt.Sprintf("'%v'", typed)
+	}
+}
+
+// suggest returns a suggestion for a misspelled field name, if there is a known field that
+// is similar enough.
+func suggest(name string, properties map[string]*object) string {
+	best := ""
+	distance := len(name)/3 + 1
+	for candidate := range properties {
+		current := levenshtein(name, candidate)
+		if current < distance || (current == distance && candidate < best) {
+			best = candidate
+			distance = current
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean '%s'?", best)
+}
+
+// levenshtein calculates the edit distance between two strings.
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,271 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+// testSpec is a small specification with the same structure than the specifications included
+// in the SDK.
+const testSpec = `{
+  "openapi": "3.0.0",
+  "paths": {
+    "/api/clusters_mgmt/v1/clusters": {
+      "get": {
+        "responses": {
+          "200": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "items": {
+                      "type": "array",
+                      "items": {
+                        "$ref": "#/components/schemas/Cluster"
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "$ref": "#/components/schemas/Cluster"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/api/clusters_mgmt/v1/clusters/{cluster_id}": {
+      "get": {
+        "responses": {
+          "200": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/Cluster"
+                }
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "$ref": "#/components/schemas/Cluster"
+              }
+            }
+          }
+        }
+      },
+      "delete": {}
+    },
+    "/api/clusters_mgmt/v1/clusters/{cluster_id}/hibernate": {
+      "post": {}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Cluster": {
+        "properties": {
+          "kind": {"type": "string"},
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "multi_az": {"type": "boolean"},
+          "expiration_timestamp": {"type": "string", "format": "date-time"},
+          "state": {"$ref": "#/components/schemas/ClusterState"},
+          "nodes": {"$ref": "#/components/schemas/ClusterNodes"},
+          "properties": {
+            "type": "object",
+            "additionalProperties": {"type": "string"}
+          },
+          "subnets": {
+            "type": "array",
+            "items": {"type": "string"}
+          },
+          "labels": {
+            "type": "array",
+            "items": {"$ref": "#/components/schemas/Label"}
+          }
+        }
+      },
+      "ClusterNodes": {
+        "properties": {
+          "compute": {"type": "integer", "format": "int32"},
+          "ratio": {"type": "number", "format": "float"}
+        }
+      },
+      "ClusterState": {
+        "type": "string",
+        "enum": ["ready", "error"]
+      },
+      "Label": {
+        "properties": {
+          "key": {"type": "string"},
+          "value": {"type": "string"}
+        },
+        "required": ["key"]
+      }
+    }
+  }
+}`
+
+var _ = Describe("Catalog", func() {
+	var catalog *Catalog
+
+	BeforeEach(func() {
+		var err error
+		catalog, err = NewCatalog().
+			Spec([]byte(testSpec)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Accepts a valid body", func() {
+		problems, found, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"name": "my-cluster",
+			"multi_az": true,
+			"expiration_timestamp": "2026-10-17T10:00:00Z",
+			"state": "ready",
+			"nodes": {"compute": 3, "ratio": 0.5},
+			"properties": {"owner": "me"},
+			"subnets": ["a", "b"],
+			"labels": [{"key": "x", "value": "y"}]
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(problems).To(BeEmpty())
+	})
+
+	It("Reports unknown fields", func() {
+		problems, found, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"name": "my-cluster",
+			"nodes": {"computes": 3}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(problems).To(HaveLen(1))
+		Expect(problems[0].String()).To(Equal("nodes.computes: unknown field, did you mean 'compute'?"))
+	})
+
+	It("Reports wrong types", func() {
+		problems, _, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"name": 123,
+			"multi_az": "true",
+			"nodes": {"compute": 1.5},
+			"subnets": "a"
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(Equal([]Problem{
+			{Path: "multi_az", Message: "expected a boolean but got string 'true'"},
+			{Path: "name", Message: "expected a string but got number '123'"},
+			{Path: "nodes.compute", Message: "expected an integer but got '1.5'"},
+			{Path: "subnets", Message: "expected an array but got string 'a'"},
+		}))
+	})
+
+	It("Reports invalid enumerated values", func() {
+		problems, _, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"state": "broken"
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(HaveLen(1))
+		Expect(problems[0].String()).To(Equal(
+			"state: value 'broken' isn't valid, valid values are 'ready', 'error'",
+		))
+	})
+
+	It("Reports invalid dates", func() {
+		problems, _, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"expiration_timestamp": "tomorrow"
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(HaveLen(1))
+		Expect(problems[0].Path).To(Equal("expiration_timestamp"))
+	})
+
+	It("Checks values of maps and items of arrays", func() {
+		problems, _, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{
+			"properties": {"owner": 1},
+			"labels": [{"key": "x"}, {"value": "y"}]
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(problems).To(Equal([]Problem{
+			{Path: "labels[1].key", Message: "required field is missing"},
+			{Path: "properties.owner", Message: "expected a string but got number '1'"},
+		}))
+	})
+
+	It("Matches paths with identifiers and query parameters", func() {
+		problems, found, err := catalog.Validate(
+			"PATCH",
+			"/api/clusters_mgmt/v1/clusters/123?dryRun=true",
+			[]byte(`{"nme": "my-cluster"}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(problems).To(HaveLen(1))
+		Expect(problems[0].Path).To(Equal("nme"))
+	})
+
+	It("Ignores operations without body specification", func() {
+		problems, found, err := catalog.Validate(
+			"POST",
+			"/api/clusters_mgmt/v1/clusters/123/hibernate",
+			[]byte(`{"junk": true}`),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+		Expect(problems).To(BeEmpty())
+	})
+
+	It("Ignores unknown paths", func() {
+		_, found, err := catalog.Validate("POST", "/api/other/v1/things", []byte(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fails if the body isn't valid JSON", func() {
+		_, _, err := catalog.Validate("POST", "/api/clusters_mgmt/v1/clusters", []byte(`{`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Mode", func() {
+	It("Accepts the valid modes", func() {
+		Expect(CheckMode(ModeStrict)).To(Succeed())
+		Expect(CheckMode(ModeWarn)).To(Succeed())
+		Expect(CheckMode(ModeNone)).To(Succeed())
+	})
+
+	It("Rejects other modes", func() {
+		Expect(CheckMode("loose")).To(MatchError(ContainSubstring("'loose' isn't valid")))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the functions that manage the resource aliases defined by the user in the
+// configuration. The template of an alias is a path that can contain numbered placeholders like
+// `{1}` and `{2}`, replaced by the arguments that follow the alias in the command line. For
+// example, with this alias:
+//
+//	mp: /api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}
+//
+// The 'ocm get mp 123 worker' command gets the 'worker' machine pool of cluster '123'.
+
+package urls
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+)
+
+// Alias describes a resource alias, either built-in or defined by the user.
+type Alias struct {
+	Name     string
+	Template string
+	BuiltIn  bool
+}
+
+// aliasNameRE is the regular expression that alias names should match. Names can't contain
+// slashes, so that they can't be confused with paths, or equals signs, so that they can't be
+// confused with the field assignments of the 'post' and 'patch' commands.
+var aliasNameRE = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// placeholderRE is the regular expression that matches the placeholders of templates.
+var placeholderRE = regexp.MustCompile(`\{([0-9]+)\}`)
+
+// CheckAlias checks that the given name and template are valid for an alias. The template should
+// be an absolute path, and the placeholders should be numbered consecutively starting with one.
+func CheckAlias(name, template string) error {
+	if !aliasNameRE.MatchString(name) {
+		return fmt.Errorf(
+			"alias name '%s' isn't valid, it should contain only letters, digits, "+
+				"underscores, dots and dashes",
+			name,
+		)
+	}
+	if !strings.HasPrefix(template, "/") {
+		return fmt.Errorf("template '%s' of alias '%s' should start with a slash", template, name)
+	}
+	for _, match := range placeholderRE.FindAllStringSubmatch(template, -1) {
+		if index, _ := strconv.Atoi(match[1]); index < 1 {
+			return fmt.Errorf(
+				"template '%s' of alias '%s' uses placeholder '%s', but placeholders "+
+					"start with '{1}'",
+				template, name, match[0],
+			)
+		}
+	}
+	count := countPlaceholders(template)
+	for i := 1; i <= count; i++ {
+		if !strings.Contains(template, fmt.Sprintf("{%d}", i)) {
+			return fmt.Errorf(
+				"template '%s' of alias '%s' uses placeholder '{%d}' but not '{%d}'",
+				template, name, count, i,
+			)
+		}
+	}
+	return nil
+}
+
+// UserAliases returns the aliases defined by the user in the configuration of the active profile.
+func UserAliases() (result map[string]string, err error) {
+	cfg, err := config.LoadStored()
+	if err != nil || cfg == nil {
+		return
+	}
+	result = cfg.Aliases
+	return
+}
+
+// Aliases returns all the aliases, including the built-in ones and the ones defined by the user,
+// sorted by name. The templates of the built-in aliases use the same placeholders than the ones
+// defined by the user. User aliases with the same name than a built-in alias replace it.
+func Aliases() (result []*Alias, err error) {
+	user, err := UserAliases()
+	if err != nil {
+		return
+	}
+	index := map[string]*Alias{}
+	for name, path := range listResourceURLs {
+		index[name] = &Alias{
+			Name:     name,
+			Template: path,
+			BuiltIn:  true,
+		}
+	}
+	for name, path := range individualResourceURLs {
+		index[name] = &Alias{
+			Name:     name,
+			Template: strings.ReplaceAll(path, "%s", "{1}"),
+			BuiltIn:  true,
+		}
+	}
+	for name, template := range user {
+		index[name] = &Alias{
+			Name:     name,
+			Template: template,
+		}
+	}
+	result = make([]*Alias, 0, len(index))
+	for _, alias := range index {
+		result = append(result, alias)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return
+}
+
+// expandAlias replaces the placeholders of the template of the given alias with the given
+// arguments. The number of arguments should be the same than the number of placeholders.
+func expandAlias(name, template string, args []string) (string, error) {
+	count := countPlaceholders(template)
+	if len(args) != count {
+		return "", fmt.Errorf(
+			"Alias '%s' expects %d arguments but got %d, its template is '%s'",
+			name, count, len(args), template,
+		)
+	}
+	result := placeholderRE.ReplaceAllStringFunc(template, func(placeholder string) string {
+		index, _ := strconv.Atoi(placeholder[1 : len(placeholder)-1])
+		if index < 1 {
+			return placeholder
+		}
+		return args[index-1]
+	})
+	return result, nil
+}
+
+// countPlaceholders returns the highest placeholder number used in the given template.
+func countPlaceholders(template string) int {
+	result := 0
+	for _, match := range placeholderRE.FindAllStringSubmatch(template, -1) {
+		index, err := strconv.Atoi(match[1])
+		if err == nil && index > result {
+			result = index
+		}
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package urls
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Aliases", func() {
+	BeforeEach(func() {
+		err := os.WriteFile(os.Getenv("OCM_CONFIG"), []byte(`{
+			"aliases": {
+				"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}",
+				"mps": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools",
+				"cluster": "/api/clusters_mgmt/v1/clusters/{1}?fetchLabels=true"
+			}
+		}`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Expands aliases with multiple arguments", func() {
+		path, err := Expand([]string{"mp", "123", "worker"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"))
+	})
+
+	It("Expands aliases with one argument", func() {
+		path, err := Expand([]string{"mps", "123"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/clusters_mgmt/v1/clusters/123/machine_pools"))
+	})
+
+	It("Fails if the number of arguments doesn't match the template", func() {
+		_, err := Expand([]string{"mp", "123"})
+		Expect(err).To(MatchError(ContainSubstring("Alias 'mp' expects 2 arguments but got 1")))
+	})
+
+	It("Prefers user aliases to built-in aliases", func() {
+		path, err := Expand([]string{"cluster", "123"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/clusters_mgmt/v1/clusters/123?fetchLabels=true"))
+	})
+
+	It("Still expands built-in aliases", func() {
+		path, err := Expand([]string{"org", "123"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/accounts_mgmt/v1/organizations/123"))
+	})
+
+	It("Lists built-in and user aliases sorted by name", func() {
+		aliases, err := Aliases()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aliases).To(ContainElement(&Alias{
+			Name:     "mp",
+			Template: "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}",
+		}))
+		Expect(aliases).To(ContainElement(&Alias{
+			Name:     "org",
+			Template: "/api/accounts_mgmt/v1/organizations/{1}",
+			BuiltIn:  true,
+		}))
+		for i := 1; i < len(aliases); i++ {
+			Expect(aliases[i-1].Name < aliases[i].Name).To(BeTrue())
+		}
+	})
+
+	It("Completes the names of the aliases", func() {
+		names, _ := CompleteResources(nil, nil, "")
+		Expect(names).To(ContainElements("mp", "mps", "clusters", "org"))
+		names, _ = CompleteResources(nil, []string{"mp"}, "")
+		Expect(names).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Aliases with broken configuration", func() {
+	BeforeEach(func() {
+		err := os.WriteFile(os.Getenv("OCM_CONFIG"), []byte(`{ junk`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Doesn't load the configuration for absolute paths", func() {
+		path, err := Expand([]string{"/api/clusters_mgmt/v1/clusters"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/clusters_mgmt/v1/clusters"))
+	})
+
+	It("Uses the built-in aliases", func() {
+		path, err := Expand([]string{"org", "123"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/api/accounts_mgmt/v1/organizations/123"))
+	})
+})
+
+var _ = Describe("Check alias", func() {
+	DescribeTable(
+		"Valid aliases",
+		func(name, template string) {
+			Expect(CheckAlias(name, template)).To(Succeed())
+		},
+		Entry("Without placeholders", "quotas", "/api/accounts_mgmt/v1/quota_cost"),
+		Entry("With one placeholder", "np", "/api/clusters_mgmt/v1/clusters/{1}/node_pools"),
+		Entry("With repeated placeholders", "x", "/api/{1}/v1/{2}?search={1}"),
+	)
+
+	DescribeTable(
+		"Invalid aliases",
+		func(name, template, message string) {
+			Expect(CheckAlias(name, template)).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("Name with slash", "a/b", "/api", "isn't valid"),
+		Entry("Name with equals sign", "a=b", "/api", "isn't valid"),
+		Entry("Relative template", "a", "api/clusters_mgmt", "should start with a slash"),
+		Entry("Missing placeholder", "a", "/api/{2}", "uses placeholder '{2}' but not '{1}'"),
+		Entry("Placeholder zero", "a", "/api/{0}", "placeholders start with '{1}'"),
+	)
+})
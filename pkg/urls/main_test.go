@@ -1,13 +1,23 @@
 package urls
 
 import (
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/ocm-cli/pkg/properties"
 )
 
 func TestURLs(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "URLs")
 }
+
+// Make sure that the tests don't use the configuration of the user, as it may contain aliases:
+var _ = BeforeEach(func() {
+	GinkgoT().Setenv("OCM_CONFIG", filepath.Join(GinkgoT().TempDir(), "ocm.json"))
+	GinkgoT().Setenv(properties.KeyringEnvKey, "")
+	GinkgoT().Setenv(properties.ProfileEnvKey, "")
+})
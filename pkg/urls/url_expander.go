@@ -18,6 +18,10 @@ package urls
 
 import (
 	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
 )
 
 // Resources that return a list of multiple items
@@ -64,8 +68,21 @@ var individualResourceURLs = map[string]string{
 // allows for shortcuts on the CLI, such as replace "accts" with the
 // full URI of the resource. Lists of resources require just the alias as
 // a parameter, while getting/posting individual resources requires the additional
-// ID of the resource.
+// ID of the resource. Aliases defined by the user in the configuration take
+// precedence over the built-in ones, and require one argument for each of the
+// placeholders of their templates. Absolute paths are never aliases, so the configuration isn't
+// loaded for them. If the aliases of the user can't be loaded only the built-in ones are used.
 func Expand(argv []string) (string, error) {
+	if len(argv) > 0 && !strings.HasPrefix(argv[0], "/") {
+		aliases, err := UserAliases()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: can't load aliases, using only the built-in ones: %v\n", err)
+		}
+		if template, ok := aliases[argv[0]]; ok {
+			return expandAlias(argv[0], template, argv[1:])
+		}
+	}
+
 	if len(argv) < 1 || len(argv) > 2 {
 		msg := fmt.Errorf("Expected 1 (for Lists) or 2 (for a specific resource) but got %d", len(argv))
 		return "", msg
@@ -100,6 +117,24 @@ func Resources() []string {
 	return resources
 }
 
+// CompleteResources completes the first argument of the commands that accept resource aliases
+// with the sorted names of the built-in aliases and the aliases defined by the user.
+func CompleteResources(cmd *cobra.Command, args []string,
+	toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	aliases, err := Aliases()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, len(aliases))
+	for i, alias := range aliases {
+		names[i] = alias.Name
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
 func expandResourceWithID(path string, argv []string) (string, error) {
 	if len(argv) != 2 {
 		return "", fmt.Errorf("Resource requires an ID, but got none")
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Alias", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Sets alias", func() {
+		result := NewCommand().
+			ConfigString(`{}`).
+			Args("alias", "set", "mp", "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"aliases": {
+				"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}"
+			}
+		}`))
+	})
+
+	It("Rejects alias with invalid template", func() {
+		result := NewCommand().
+			ConfigString(`{}`).
+			Args("alias", "set", "mp", "/api/clusters_mgmt/v1/clusters/{2}").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("uses placeholder '{2}' but not '{1}'"))
+	})
+
+	It("Lists user aliases", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"aliases": {
+					"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}",
+					"np": "/api/clusters_mgmt/v1/clusters/{1}/node_pools/{2}"
+				}
+			}`).
+			Args("alias", "list", "--user", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		Expect(result.OutLines()).To(Equal([]string{
+			"NAME,TEMPLATE,SOURCE",
+			"mp,/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2},user",
+			"np,/api/clusters_mgmt/v1/clusters/{1}/node_pools/{2},user",
+		}))
+	})
+
+	It("Unsets alias", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"pager": "less",
+				"aliases": {
+					"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}"
+				}
+			}`).
+			Args("alias", "unset", "mp").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"pager": "less"
+		}`))
+	})
+
+	It("Uses alias with multiple arguments", func() {
+		// Prepare the server:
+		apiServer := MakeTCPServer()
+		defer apiServer.Close()
+		apiServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools/worker"),
+				RespondWithJSON(http.StatusOK, `{ "id": "worker" }`),
+			),
+		)
+
+		// Run the command:
+		result := NewCommand().
+			ConfigString(
+				`{
+					"access_token": "{{ .accessToken }}",
+					"url": "{{ .url }}",
+					"aliases": {
+						"mp": "/api/clusters_mgmt/v1/clusters/{1}/machine_pools/{2}"
+					}
+				}`,
+				"accessToken", MakeTokenString("Bearer", 15*time.Minute),
+				"url", apiServer.URL(),
+			).
+			Args("get", "mp", "123", "worker").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		Expect(result.OutString()).To(MatchJSON(`{ "id": "worker" }`))
+	})
+})
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("API resources", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Lists the collections of a service", func() {
+		result := NewCommand().
+			Args("api-resources", "--output", "csv", "--service", "clusters_mgmt").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		lines := result.OutLines()
+		Expect(lines[0]).To(Equal("SERVICE,VERSION,PATH,METHODS"))
+		Expect(lines).To(ContainElement("clusters_mgmt,v1,/api/clusters_mgmt/v1/clusters,GET,POST"))
+		for _, line := range lines[1:] {
+			Expect(line).To(HavePrefix("clusters_mgmt,"))
+		}
+	})
+
+	It("Rejects unknown services", func() {
+		result := NewCommand().
+			Args("api-resources", "--service", "my_service").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("Unknown service 'my_service'"))
+	})
+
+	It("Completes path segments", func() {
+		result := NewCommand().
+			Args("__complete", "get", "/api/clusters_mgmt/v1/cl").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutLines()).To(ContainElement("/api/clusters_mgmt/v1/clusters"))
+	})
+
+	When("Logged in", func() {
+		var ssoServer *Server
+		var apiServer *Server
+		var config string
+
+		BeforeEach(func() {
+			// Create the servers:
+			ssoServer = MakeTCPServer()
+			apiServer = MakeTCPServer()
+
+			// Prepare the server:
+			accessToken := MakeTokenString("Bearer", 15*time.Minute)
+			ssoServer.AppendHandlers(
+				RespondWithAccessToken(accessToken),
+			)
+
+			// Login:
+			result := NewCommand().
+				Args(
+					"login",
+					"--client-id", "my-client",
+					"--client-secret", "my-secret",
+					"--token-url", ssoServer.URL(),
+					"--url", apiServer.URL(),
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			config = result.ConfigString()
+		})
+
+		AfterEach(func() {
+			// Close the servers:
+			ssoServer.Close()
+			apiServer.Close()
+		})
+
+		It("Completes the identifiers of the items of collections", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 2,
+						"total": 2,
+						"items": [
+							{ "id": "123", "name": "my_cluster" },
+							{ "id": "456", "name": "your_cluster" }
+						]
+					}`),
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("__complete", "get", "/api/clusters_mgmt/v1/clusters/").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			lines := result.OutLines()
+			Expect(lines).To(ContainElement("/api/clusters_mgmt/v1/clusters/123\tmy_cluster"))
+			Expect(lines).To(ContainElement("/api/clusters_mgmt/v1/clusters/456\tyour_cluster"))
+		})
+	})
+})
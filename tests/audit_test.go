@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Audit", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Fails if the audit log isn't enabled", func() {
+		result := NewCommand().
+			ConfigString(`{}`).
+			Args("audit", "log").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("The audit log isn't enabled"))
+	})
+
+	When("Logged in with the audit log enabled", func() {
+		var ssoServer *Server
+		var apiServer *Server
+		var config string
+		var file string
+
+		BeforeEach(func() {
+			// Create the servers:
+			ssoServer = MakeTCPServer()
+			apiServer = MakeTCPServer()
+
+			// Prepare the server:
+			accessToken := MakeTokenString("Bearer", 15*time.Minute)
+			ssoServer.AppendHandlers(
+				RespondWithAccessToken(accessToken),
+			)
+
+			// Login:
+			result := NewCommand().
+				Args(
+					"login",
+					"--client-id", "my-client",
+					"--client-secret", "my-secret",
+					"--token-url", ssoServer.URL(),
+					"--url", apiServer.URL(),
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+
+			// Enable the audit log:
+			file = filepath.Join(GinkgoT().TempDir(), "audit.jsonl")
+			result = NewCommand().
+				ConfigString(result.ConfigString()).
+				Args("config", "set", "audit_log", file).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			config = result.ConfigString()
+		})
+
+		AfterEach(func() {
+			// Close the servers:
+			ssoServer.Close()
+			apiServer.Close()
+		})
+
+		It("Records mutating requests", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{ "kind": "ClusterList", "items": [] }`),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWith(
+						http.StatusNoContent,
+						nil,
+						http.Header{"X-Operation-Id": []string{"my-operation"}},
+					),
+				),
+			)
+
+			// Send a request that isn't recorded and one that is:
+			result := NewCommand().
+				ConfigString(config).
+				Args("get", "/api/clusters_mgmt/v1/clusters").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			result = NewCommand().
+				ConfigString(config).
+				Args("delete", "/api/clusters_mgmt/v1/clusters/123").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+
+			// Check the audit log:
+			result = NewCommand().
+				ConfigString(config).
+				Args("audit", "log", "--output", "csv", "--since", "1h").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			lines := result.OutLines()
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(Equal("TIME,USER,METHOD,PATH,STATUS,REQUEST ID,COMMAND"))
+			Expect(lines[1]).To(ContainSubstring(
+				",DELETE,/api/clusters_mgmt/v1/clusters/123,204,my-operation,",
+			))
+			Expect(lines[1]).To(HaveSuffix(" delete /api/clusters_mgmt/v1/clusters/123"))
+		})
+
+		It("Doesn't record requests in dry run mode", func() {
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("delete", "--dry-run", "/api/clusters_mgmt/v1/clusters/123").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+
+			// Check the audit log:
+			result = NewCommand().
+				ConfigString(config).
+				Args("audit", "log", "--file", file, "--output", "json").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.OutString()).To(MatchJSON(`[]`))
+		})
+	})
+})
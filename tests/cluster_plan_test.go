@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Cluster plan and apply", func() {
+	var ctx context.Context
+	var ssoServer *Server
+	var apiServer *Server
+	var config string
+	var file string
+
+	// Responses used to find the cluster:
+	subscriptions := `{
+		"kind": "SubscriptionList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Subscription",
+				"id": "456",
+				"status": "Active",
+				"cluster_id": "123"
+			}
+		]
+	}`
+	cluster := `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "my-cluster",
+		"cloud_provider": {
+			"id": "aws"
+		},
+		"region": {
+			"id": "us-east-1"
+		},
+		"multi_az": false,
+		"api": {
+			"listening": "external"
+		},
+		"nodes": {
+			"compute": 3
+		}
+	}`
+
+	// writeSpec writes the specification file used by the commands.
+	writeSpec := func(text string) {
+		err := os.WriteFile(file, []byte(text), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the servers:
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(
+			RespondWithAccessToken(accessToken),
+		)
+
+		// Login:
+		result := NewCommand().
+			Args(
+				"login",
+				"--client-id", "my-client",
+				"--client-secret", "my-secret",
+				"--token-url", ssoServer.URL(),
+				"--url", apiServer.URL(),
+			).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		config = result.ConfigString()
+
+		file = filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+	})
+
+	AfterEach(func() {
+		// Close the servers:
+		ssoServer.Close()
+		apiServer.Close()
+	})
+
+	It("Shows the changes", func() {
+		writeSpec(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"name": "my-cluster",
+			"region": "us-west-2",
+			"private": true,
+			"compute": {"nodes": 6}
+		}`)
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("cluster", "plan", "-f", file).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutLines()).To(Equal([]string{
+			"Cluster 'my-cluster' (123) differs from the specification:",
+			"  ! region: us-east-1 -> us-west-2 (can't be changed)",
+			"  ~ private: false -> true",
+			"  ~ compute.nodes: 3 -> 6",
+			"",
+			"2 to change, 1 can't be changed",
+		}))
+	})
+
+	It("Reports that the cluster matches", func() {
+		writeSpec(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"region": "us-east-1",
+			"compute": {"nodes": 3}
+		}`)
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("cluster", "plan", "-f", file, "my-cluster").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutLines()).To(Equal([]string{
+			"Cluster 'my-cluster' (123) matches the specification",
+		}))
+	})
+
+	It("Applies only the changed fields", func() {
+		writeSpec(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"name": "my-cluster",
+			"region": "us-east-1",
+			"compute": {"nodes": 6}
+		}`)
+		var body []byte
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+				func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					body, err = io.ReadAll(r.Body)
+					Expect(err).ToNot(HaveOccurred())
+				},
+				RespondWithJSON(http.StatusOK, cluster),
+			),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("cluster", "apply", "-f", file).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutString()).To(ContainSubstring("Cluster 'my-cluster' updated"))
+		Expect(body).To(MatchJSON(`{
+			"kind": "Cluster",
+			"nodes": {
+				"compute": 6
+			}
+		}`))
+	})
+
+	It("Refuses to change immutable fields", func() {
+		writeSpec(`{
+			"api_version": "v1",
+			"kind": "Cluster",
+			"name": "my-cluster",
+			"multi_az": true,
+			"compute": {"nodes": 6}
+		}`)
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("cluster", "apply", "-f", file).
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring(
+			"'multi_az' can't be changed after the cluster is created",
+		))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+})
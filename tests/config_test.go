@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2" // nolint
+	. "github.com/onsi/gomega"    // nolint
+)
+
+var _ = Describe("Config", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("Lists variables redacting secrets", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"client_id": "my_client",
+				"client_secret": "my_secret",
+				"pager": "less"
+			}`).
+			Args("config", "list", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		Expect(result.OutLines()).To(Equal([]string{
+			"NAME,VALUE",
+			"client_id,my_client",
+			"client_secret,REDACTED",
+			"pager,less",
+		}))
+	})
+
+	It("Gets list variable with the Go syntax", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"scopes": ["openid", "email"]
+			}`).
+			Args("config", "get", "scopes").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		Expect(result.OutString()).To(Equal("[openid email]\n"))
+	})
+
+	It("Gets boolean variable", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"opaque_token": true
+			}`).
+			Args("config", "get", "opaque_token").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutString()).To(Equal("true\n"))
+	})
+
+	It("Sets boolean variable", func() {
+		result := NewCommand().
+			ConfigString(`{}`).
+			Args("config", "set", "opaque_token", "true").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"opaque_token": true
+		}`))
+	})
+
+	It("Rejects unknown variable", func() {
+		result := NewCommand().
+			ConfigString(`{}`).
+			Args("config", "set", "junk", "value").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("unknown setting 'junk'"))
+	})
+
+	It("Unsets variable", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"pager": "less",
+				"url": "https://my.server.com"
+			}`).
+			Args("config", "unset", "pager").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"url": "https://my.server.com"
+		}`))
+	})
+
+	It("Saves valid changes made with the editor", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"pager": "less"
+			}`).
+			Env("EDITOR", "sed -i s/less/more/").
+			Args("config", "edit").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"pager": "more"
+		}`))
+	})
+
+	It("Doesn't save invalid changes made with the editor", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"url": "https://my.server.com"
+			}`).
+			Env("EDITOR", "sed -i s|https://my.server.com|junk|").
+			Args("config", "edit").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("isn't a valid absolute URL"))
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"url": "https://my.server.com"
+		}`))
+	})
+
+	It("Shows the origin of the effective values", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"client_id": "my_client"
+			}`).
+			Env("OCM_PAGER", "less").
+			Args("config", "view", "--show-origin", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		lines := result.OutLines()
+		Expect(lines).To(ContainElement(MatchRegexp(`^client_id,my_client,file:.*\.ocm\.json$`)))
+		Expect(lines).To(ContainElement("pager,less,env:OCM_PAGER"))
+		Expect(lines).To(ContainElement("url,https://api.openshift.com,default"))
+	})
+
+	It("Shows the defaults and the environment when there is no configuration file", func() {
+		result := NewCommand().
+			Env("OCM_PAGER", "less").
+			Args("config", "view", "--show-origin", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		lines := result.OutLines()
+		Expect(lines).To(ContainElement("pager,less,env:OCM_PAGER"))
+		Expect(lines).To(ContainElement("url,https://api.openshift.com,default"))
+	})
+
+	It("Warns about an invalid environment variable and ignores it", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"client_id": "my_client"
+			}`).
+			Env("OCM_OPAQUE_TOKEN", "junk").
+			Args("config", "view", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring(
+			"Warning: ignoring environment variable 'OCM_OPAQUE_TOKEN'",
+		))
+		Expect(result.OutLines()).To(ContainElement("client_id,my_client"))
+		Expect(result.OutLines()).ToNot(ContainElement(HavePrefix("opaque_token,")))
+	})
+
+	It("Shows the URL overridden by the environment", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"url": "https://my.server.com"
+			}`).
+			Env("OCM_URL", "https://my.other.server.com").
+			Args("config", "view", "--show-origin", "--output", "csv").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(BeEmpty())
+		Expect(result.OutLines()).To(ContainElement(
+			"url,https://my.other.server.com,env:OCM_URL",
+		))
+	})
+})
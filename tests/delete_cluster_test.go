@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Delete cluster", func() {
+	var ctx context.Context
+	var ssoServer *Server
+	var apiServer *Server
+	var config string
+
+	// Responses used to find the cluster:
+	subscriptions := `{
+		"kind": "SubscriptionList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Subscription",
+				"id": "456",
+				"status": "Active",
+				"cluster_id": "123"
+			}
+		]
+	}`
+	cluster := `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "my-cluster",
+		"state": "ready",
+		"cloud_provider": {
+			"id": "aws"
+		},
+		"region": {
+			"id": "us-east-1"
+		}
+	}`
+	protected := `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "my-cluster",
+		"state": "ready",
+		"delete_protection": {
+			"enabled": true
+		}
+	}`
+
+	BeforeEach(func() {
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the servers:
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(
+			RespondWithAccessToken(accessToken),
+		)
+
+		// Login:
+		result := NewCommand().
+			Args(
+				"login",
+				"--client-id", "my-client",
+				"--client-secret", "my-secret",
+				"--token-url", ssoServer.URL(),
+				"--url", apiServer.URL(),
+			).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		config = result.ConfigString()
+	})
+
+	AfterEach(func() {
+		// Close the servers:
+		ssoServer.Close()
+		apiServer.Close()
+	})
+
+	It("Deletes the cluster when the name is typed", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			InString("my-cluster\n").
+			Args("delete", "cluster", "123").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.OutString()).To(ContainSubstring("Name:		my-cluster"))
+		Expect(result.OutString()).To(ContainSubstring("Cluster 'my-cluster' will start uninstalling"))
+	})
+
+	It("Doesn't delete the cluster when the name doesn't match", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			InString("other-cluster\n").
+			Args("delete", "cluster", "123").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("cluster 'my-cluster' wasn't deleted"))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Sends the deprovision and best effort parameters", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+			CombineHandlers(
+				VerifyRequest(
+					http.MethodDelete,
+					"/api/clusters_mgmt/v1/clusters/123",
+					"best_effort=true&deprovision=false",
+				),
+				RespondWith(http.StatusNoContent, nil),
+			),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args(
+				"delete", "cluster", "my-cluster",
+				"--yes", "--deprovision=false", "--best-effort",
+			).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+	})
+
+	It("Refuses to delete clusters with delete protection", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, protected),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("delete", "cluster", "123", "--yes").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("has delete protection enabled"))
+		Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("Waits till the cluster is uninstalled", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSON(http.StatusOK, cluster),
+			RespondWith(http.StatusNoContent, nil),
+			RespondWithJSON(http.StatusNotFound, `{
+				"kind": "Error",
+				"id": "404",
+				"href": "/api/clusters_mgmt/v1/errors/404",
+				"code": "CLUSTERS-MGMT-404",
+				"reason": "Cluster '123' not found"
+			}`),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("delete", "cluster", "123", "--yes", "--watch").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("Cluster '123' has been uninstalled"))
+	})
+})
@@ -19,6 +19,8 @@ package tests
 import (
 	"context"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"    // nolint
@@ -162,6 +164,74 @@ var _ = Describe("Get", func() {
 			Expect(result.OutString()).To(MatchJSON(`{ "my_field": "my_value" }`))
 		})
 
+		It("Writes the result of the --output jsonpath template", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"items": [
+							{ "id": "123", "name": "my_cluster" },
+							{ "id": "456", "name": "your_cluster" }
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get",
+					"--output", `jsonpath={range .items[*]}{.id} {.name}{"\n"}{end}`,
+					"/api/clusters_mgmt/v1/clusters",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(Equal(
+				"123 my_cluster\n" +
+					"456 your_cluster\n",
+			))
+		})
+
+		It("Writes the result of the -o go-template template", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{ "my_field": "my_value" }`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get",
+					"-o", "go-template={{ .my_field }}",
+					"/api/my_service/v1/my_object",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(Equal("my_value\n"))
+		})
+
+		It("Rejects unsupported --output format", func() {
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get",
+					"--output", "csv",
+					"/api/my_service/v1/my_object",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring("isn't supported"))
+		})
+
 		It("Honours the --parameter flag", func() {
 			// Prepare the server:
 			apiServer.AppendHandlers(
@@ -313,5 +383,288 @@ var _ = Describe("Get", func() {
 				`,
 			)))
 		})
+
+		It("Merges all the pages with --all", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyFormKV("page", "1"),
+					VerifyFormKV("size", "2"),
+					RespondWithJSON(
+						http.StatusOK,
+						`{
+							"kind": "ClusterList",
+							"page": 1,
+							"size": 2,
+							"total": 3,
+							"items": [
+								{ "id": "123" },
+								{ "id": "456" }
+							]
+						}`,
+					),
+				),
+				CombineHandlers(
+					VerifyFormKV("page", "2"),
+					VerifyFormKV("size", "2"),
+					RespondWithJSON(
+						http.StatusOK,
+						`{
+							"kind": "ClusterList",
+							"page": 2,
+							"size": 1,
+							"total": 3,
+							"items": [
+								{ "id": "789" }
+							]
+						}`,
+					),
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("get", "--all", "--page-size", "2", "/api/clusters_mgmt/v1/clusters").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(MatchJSON(`{
+				"kind": "ClusterList",
+				"page": 1,
+				"size": 3,
+				"total": 3,
+				"items": [
+					{ "id": "123" },
+					{ "id": "456" },
+					{ "id": "789" }
+				]
+			}`))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Streams the items with --all --stream and honours --max-items", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyFormKV("page", "1"),
+					VerifyFormKV("size", "2"),
+					RespondWithJSON(
+						http.StatusOK,
+						`{
+							"kind": "ClusterList",
+							"page": 1,
+							"size": 2,
+							"total": 10,
+							"items": [
+								{ "id": "123" },
+								{ "id": "456" }
+							]
+						}`,
+					),
+				),
+				CombineHandlers(
+					VerifyFormKV("page", "2"),
+					VerifyFormKV("size", "2"),
+					RespondWithJSON(
+						http.StatusOK,
+						`{
+							"kind": "ClusterList",
+							"page": 2,
+							"size": 2,
+							"total": 10,
+							"items": [
+								{ "id": "789" },
+								{ "id": "abc" }
+							]
+						}`,
+					),
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get", "--all", "--stream",
+					"--page-size", "2",
+					"--max-items", "3",
+					"/api/clusters_mgmt/v1/clusters",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				`{"id":"123"}`,
+				`{"id":"456"}`,
+				`{"id":"789"}`,
+			}))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Rejects the page parameter with --all", func() {
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get", "--all",
+					"--parameter", "page=2",
+					"/api/clusters_mgmt/v1/clusters",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring("--page-size"))
+		})
+
+		It("Rejects --stream without --all", func() {
+			result := NewCommand().
+				ConfigString(config).
+				Args("get", "--stream", "/api/clusters_mgmt/v1/clusters").
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring("'--all'"))
+		})
+
+		It("Filters the response with --jq and -r", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"items": [
+							{ "id": "123", "name": "my_cluster" },
+							{ "id": "456", "name": "your_cluster" }
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("get", "--jq", ".items[].name", "-r", "/api/clusters_mgmt/v1/clusters").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				"my_cluster",
+				"your_cluster",
+			}))
+		})
+
+		It("Applies --jq to each item with --all --stream", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 2,
+						"total": 2,
+						"items": [
+							{ "id": "123", "name": "my_cluster" },
+							{ "id": "456", "name": "your_cluster" }
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get", "--all", "--stream",
+					"--jq", "{id}",
+					"/api/clusters_mgmt/v1/clusters",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				`{"id":"123"}`,
+				`{"id":"456"}`,
+			}))
+		})
+
+		It("Rejects --jq together with --output", func() {
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"get", "--jq", ".", "--output", "json",
+					"/api/clusters_mgmt/v1/clusters",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring("can't be used together"))
+		})
+
+		It("Replays the traffic recorded with --record", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{ "my_field": "my_value" }`),
+			)
+
+			// Record the traffic:
+			cassette := filepath.Join(GinkgoT().TempDir(), "cassette.jsonl")
+			result := NewCommand().
+				ConfigString(config).
+				Args("--record", cassette, "get", "/api/my_service/v1/my_object").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.OutString()).To(MatchJSON(`{ "my_field": "my_value" }`))
+			data, err := os.ReadFile(cassette)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`"Bearer REDACTED"`))
+
+			// Replay it, checking that nothing is sent to the server:
+			result = NewCommand().
+				ConfigString(config).
+				Args("--replay", cassette, "get", "/api/my_service/v1/my_object").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(MatchJSON(`{ "my_field": "my_value" }`))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("Retries rate limited requests honouring Retry-After", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWith(
+					http.StatusTooManyRequests,
+					`{ "kind": "Error" }`,
+					http.Header{"Retry-After": []string{"0"}},
+				),
+				RespondWithJSON(http.StatusOK, `{ "my_field": "my_value" }`),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("--debug", "get", "/api/my_service/v1/my_object").
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.OutString()).To(MatchJSON(`{ "my_field": "my_value" }`))
+			Expect(result.ErrString()).To(ContainSubstring(
+				"/api/my_service/v1/my_object' failed with status 429, will retry in 0s (retry 1 of 3)",
+			))
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("Doesn't retry when --retries is zero", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusServiceUnavailable, `{ "kind": "Error" }`),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("--retries", "0", "get", "/api/my_service/v1/my_object").
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(apiServer.ReceivedRequests()).To(HaveLen(1))
+		})
 	})
 })
@@ -264,5 +264,226 @@ var _ = Describe("List clusters", func() {
 				`^\s*123\s+e30bac0b-b337-47d7-a378-2c302b4c868a\s+my_cluster\s*$`,
 			))
 		})
+
+		It("Writes the clusters in JSON format", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"name": "my_cluster",
+								"state": "ready"
+							}
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"list", "clusters",
+					"--columns", "id,name,state",
+					"--output", "json",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(MatchJSON(`[
+				{
+					"id": "123",
+					"name": "my_cluster",
+					"state": "ready"
+				}
+			]`))
+		})
+
+		It("Writes the clusters in CSV format", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"name": "my_cluster",
+								"state": "ready"
+							}
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"list", "clusters",
+					"--columns", "id,name,state",
+					"--output", "csv",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				"ID,NAME,STATE",
+				"123,my_cluster,ready",
+			}))
+		})
+
+		It("Sorts the clusters by the given column", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 3,
+						"total": 3,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"name": "b_cluster",
+								"openshift_version": "4.9.1"
+							},
+							{
+								"kind": "Cluster",
+								"id": "456",
+								"name": "a_cluster",
+								"openshift_version": "4.10.2"
+							},
+							{
+								"kind": "Cluster",
+								"id": "789",
+								"name": "c_cluster",
+								"openshift_version": "4.10.0"
+							}
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"list", "clusters",
+					"--columns", "id,name",
+					"--output", "csv",
+					"--sort-by", "openshift_version",
+					"--reverse",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				"ID,NAME",
+				"456,a_cluster",
+				"789,c_cluster",
+				"123,b_cluster",
+			}))
+		})
+
+		It("Fails if the sort key isn't a column or a field", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"name": "my_cluster"
+							}
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"list", "clusters",
+					"--columns", "id,name",
+					"--sort-by", "junk",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.OutString()).To(BeEmpty())
+			Expect(result.ErrString()).To(ContainSubstring(
+				"unknown sort key 'junk', valid columns are id, name, ",
+			))
+		})
+
+		It("Writes the clusters using a JSONPath template", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "ClusterList",
+						"page": 1,
+						"size": 2,
+						"total": 2,
+						"items": [
+							{
+								"kind": "Cluster",
+								"id": "123",
+								"name": "my_cluster",
+								"api": {
+									"url": "https://api.my.com:6443"
+								}
+							},
+							{
+								"kind": "Cluster",
+								"id": "456",
+								"name": "your_cluster",
+								"api": {
+									"url": "https://api.your.com:6443"
+								}
+							}
+						]
+					}`,
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"list", "clusters",
+					"--output", "jsonpath={.name} {.api.url}",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutLines()).To(Equal([]string{
+				"my_cluster https://api.my.com:6443",
+				"your_cluster https://api.your.com:6443",
+			}))
+		})
 	})
 })
@@ -18,6 +18,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"time"
 
@@ -284,4 +285,58 @@ var _ = Describe("Login", func() {
 			Expect(result.ErrString()).To(ContainSubstring("keyring is invalid"))
 		})
 	})
+
+	When("Checking the status", func() {
+		It("Describes the tokens", func() {
+			accessToken := MakeTokenString("Bearer", 15*time.Minute)
+			refreshToken := MakeTokenString("Offline", 10*time.Hour)
+			result := NewCommand().
+				ConfigString(
+					`{
+						"access_token": "{{ .accessToken }}",
+						"refresh_token": "{{ .refreshToken }}",
+						"url": "http://my-server.example.com",
+						"token_url": "http://my-sso.example.com"
+					}`,
+					"accessToken", accessToken,
+					"refreshToken", refreshToken,
+				).
+				Args("login", "status", "--output", "json").
+				Run(ctx)
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.ExitCode()).To(BeZero())
+			var status struct {
+				LoggedIn    bool   `json:"logged_in"`
+				AuthMethod  string `json:"auth_method"`
+				Storage     string `json:"storage"`
+				URL         string `json:"url"`
+				AccessToken struct {
+					Type string `json:"type"`
+				} `json:"access_token"`
+				RefreshToken struct {
+					Type    string `json:"type"`
+					Expired bool   `json:"expired"`
+				} `json:"refresh_token"`
+			}
+			err := json.Unmarshal([]byte(result.OutString()), &status)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.LoggedIn).To(BeTrue())
+			Expect(status.AuthMethod).To(Equal("offline-token"))
+			Expect(status.Storage).To(Equal("file"))
+			Expect(status.URL).To(Equal("http://my-server.example.com"))
+			Expect(status.AccessToken.Type).To(Equal("Bearer"))
+			Expect(status.RefreshToken.Type).To(Equal("Offline"))
+			Expect(status.RefreshToken.Expired).To(BeFalse())
+		})
+
+		It("Fails if not logged in", func() {
+			result := NewCommand().
+				ConfigString(`{}`).
+				Args("login", "status").
+				Run(ctx)
+			Expect(result.ExitCode()).To(Equal(1))
+			Expect(result.OutString()).To(ContainSubstring("credentials aren't set"))
+			Expect(result.ErrString()).To(ContainSubstring("Not logged in"))
+		})
+	})
 })
@@ -124,6 +124,55 @@ var _ = Describe("Logout", func() {
 		Expect(result.ConfigString()).To(MatchJSON(`{}`))
 	})
 
+	It("Removes only the settings of the selected profile", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"current_profile": "default",
+				"profiles": {
+					"default": {
+						"client_id": "my_client",
+						"client_secret": "my_secret"
+					},
+					"staging": {
+						"client_id": "your_client",
+						"client_secret": "your_secret",
+						"pager": "less"
+					}
+				}
+			}`).
+			Args("logout", "--profile", "staging").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"current_profile": "default",
+			"profiles": {
+				"default": {
+					"client_id": "my_client",
+					"client_secret": "my_secret"
+				},
+				"staging": {
+					"pager": "less"
+				}
+			}
+		}`))
+	})
+
+	It("Doesn't change anything if the selected profile doesn't exist", func() {
+		result := NewCommand().
+			ConfigString(`{
+				"client_id": "my_client",
+				"client_secret": "my_secret"
+			}`).
+			Env("OCM_PROFILE", "staging").
+			Args("logout").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ConfigString()).To(MatchJSON(`{
+			"client_id": "my_client",
+			"client_secret": "my_secret"
+		}`))
+	})
+
 	It("Doesn't remove settings not related to authentication", func() {
 		result := NewCommand().
 			ConfigString(`{
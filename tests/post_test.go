@@ -169,5 +169,129 @@ var _ = Describe("Post", func() {
 			Expect(result.ExitCode()).To(BeZero())
 			Expect(result.ErrString()).To(BeEmpty())
 		})
+
+		It("Filters the response with --jq", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				RespondWithJSON(http.StatusCreated, `{ "id": "123", "name": "my_object" }`),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("post", "--jq", ".id", "-r", "/api/my_service/v1/my_objects").
+				InString(`{ "name": "my_object" }`).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(Equal("123\n"))
+		})
+
+		It("Describes the request without sending it with --dry-run", func() {
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"post", "--dry-run",
+					"--parameter", "my_param=my_value",
+					"/api/my_service/v1/my_objects",
+				).
+				InString(`{ "name": "my_object" }`).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			lines := result.OutLines()
+			Expect(lines[0]).To(Equal(
+				"POST " + apiServer.URL() + "/api/my_service/v1/my_objects?my_param=my_value",
+			))
+			Expect(lines).To(ContainElement("Authorization: Bearer REDACTED"))
+			Expect(lines[len(lines)-1]).To(Equal(`{ "name": "my_object" }`))
+			Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Writes a curl command with --print-curl", func() {
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args("post", "--print-curl", "/api/my_service/v1/my_objects").
+				InString(`{ "name": "my_object" }`).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.OutString()).To(HavePrefix("curl --request POST"))
+			Expect(result.OutString()).To(ContainSubstring(`"Authorization: Bearer $(ocm token)"`))
+			Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Builds the body from inline fields", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyJSON(`{
+						"name": "my_object",
+						"nodes": { "compute": 3 },
+						"labels": { "env": "prod" }
+					}`),
+					RespondWithJSON(http.StatusOK, `{}`),
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"post", "/api/my_service/v1/my_objects",
+					"name=my_object",
+					"nodes.compute:=3",
+					"labels.env=prod",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(BeEmpty())
+		})
+
+		It("Doesn't send bodies that don't match the specification in strict mode", func() {
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"post", "--validate=strict", "/api/clusters_mgmt/v1/clusters",
+					"name=my_cluster",
+					"nodes.computes:=3",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring(
+				"nodes.computes: unknown field, did you mean 'compute'?",
+			))
+			Expect(apiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Sends bodies that don't match the specification in warn mode", func() {
+			// Prepare the server:
+			apiServer.AppendHandlers(
+				CombineHandlers(
+					VerifyJSON(`{
+						"name": "my_cluster",
+						"multi_az": "yes"
+					}`),
+					RespondWithJSON(http.StatusOK, `{}`),
+				),
+			)
+
+			// Run the command:
+			result := NewCommand().
+				ConfigString(config).
+				Args(
+					"post", "/api/clusters_mgmt/v1/clusters",
+					"name=my_cluster",
+					"multi_az=yes",
+				).
+				Run(ctx)
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring(
+				"multi_az: expected a boolean but got string 'yes'",
+			))
+		})
 	})
 })
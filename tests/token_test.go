@@ -18,6 +18,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2" // nolint
@@ -71,6 +72,51 @@ var _ = Describe("Token", func() {
 			Expect(result.ErrString()).To(BeEmpty())
 			Expect(result.ExitCode()).To(BeZero())
 		})
+
+		It("Displays exec credential", func() {
+			result := cmd.Arg("--exec-credential").Run(ctx)
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.ExitCode()).To(BeZero())
+			var credential map[string]interface{}
+			err := json.Unmarshal([]byte(result.OutString()), &credential)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credential).To(HaveKeyWithValue("apiVersion", "client.authentication.k8s.io/v1"))
+			Expect(credential).To(HaveKeyWithValue("kind", "ExecCredential"))
+			status, ok := credential["status"].(map[string]interface{})
+			Expect(ok).To(BeTrue())
+			Expect(status).To(HaveKeyWithValue("token", accessToken))
+			Expect(status).To(HaveKey("expirationTimestamp"))
+			expiry, err := time.Parse(time.RFC3339, status["expirationTimestamp"].(string))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiry).To(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+		})
+
+		It("Rejects exec credential with refresh token", func() {
+			result := cmd.Args("--exec-credential", "--refresh").Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring("'--exec-credential' can't be used"))
+		})
+
+		It("Displays credential for matching host", func() {
+			result := cmd.Args("helper", "get").
+				InString("protocol=http\nhost=my-server.example.com\n\n").
+				Run(ctx)
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.ExitCode()).To(BeZero())
+			lines := result.OutLines()
+			Expect(lines).To(ContainElement("host=my-server.example.com"))
+			Expect(lines).To(ContainElement("password=" + accessToken))
+			Expect(lines).To(ContainElement(HavePrefix("password_expiry_utc=")))
+		})
+
+		It("Doesn't display credential for other host", func() {
+			result := cmd.Args("helper", "get").
+				InString("protocol=https\nhost=github.com\n\n").
+				Run(ctx)
+			Expect(result.ErrString()).To(BeEmpty())
+			Expect(result.ExitCode()).To(BeZero())
+			Expect(result.OutString()).To(BeEmpty())
+		})
 	})
 
 	When("Logged in with opaque token", func() {
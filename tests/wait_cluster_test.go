@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
+
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+)
+
+var _ = Describe("Wait for cluster", func() {
+	var ctx context.Context
+	var ssoServer *Server
+	var apiServer *Server
+	var config string
+
+	// Responses used to find the cluster:
+	subscriptions := `{
+		"kind": "SubscriptionList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [
+			{
+				"kind": "Subscription",
+				"id": "456",
+				"status": "Active",
+				"cluster_id": "123"
+			}
+		]
+	}`
+	cluster := `{
+		"kind": "Cluster",
+		"id": "123",
+		"name": "my-cluster",
+		"state": "{{ .State }}"
+	}`
+
+	BeforeEach(func() {
+		// Create a context:
+		ctx = context.Background()
+
+		// Create the servers:
+		ssoServer = MakeTCPServer()
+		apiServer = MakeTCPServer()
+
+		// Prepare the server:
+		accessToken := MakeTokenString("Bearer", 15*time.Minute)
+		ssoServer.AppendHandlers(
+			RespondWithAccessToken(accessToken),
+		)
+
+		// Login:
+		result := NewCommand().
+			Args(
+				"login",
+				"--client-id", "my-client",
+				"--client-secret", "my-secret",
+				"--token-url", ssoServer.URL(),
+				"--url", apiServer.URL(),
+			).
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		config = result.ConfigString()
+	})
+
+	AfterEach(func() {
+		// Close the servers:
+		ssoServer.Close()
+		apiServer.Close()
+	})
+
+	It("Waits till the cluster is hibernating", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "ready"),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/hibernate"),
+				RespondWith(http.StatusAccepted, nil),
+			),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "hibernating"),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("hibernate", "cluster", "my-cluster", "--wait").
+			Run(ctx)
+		Expect(result.ExitCode()).To(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring("Waiting for cluster '123' to be hibernating"))
+		Expect(result.ErrString()).To(ContainSubstring("Cluster 'my-cluster' is hibernating"))
+	})
+
+	It("Exits with a specific code when the cluster fails", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "hibernating"),
+			RespondWith(http.StatusAccepted, nil),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "error"),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("resume", "cluster", "my-cluster", "--wait").
+			Run(ctx)
+		Expect(result.ExitCode()).To(Equal(2))
+		Expect(result.ErrString()).To(ContainSubstring("Cluster 'my-cluster' is error, it won't be ready"))
+	})
+
+	It("Exits with a specific code when the timeout expires", func() {
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, subscriptions),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "hibernating"),
+			RespondWith(http.StatusAccepted, nil),
+			RespondWithJSONTemplate(http.StatusOK, cluster, "State", "resuming"),
+		)
+		result := NewCommand().
+			ConfigString(config).
+			Args("resume", "cluster", "my-cluster", "--wait", "--wait-timeout", "1s").
+			Run(ctx)
+		Expect(result.ExitCode()).To(Equal(3))
+		Expect(result.ErrString()).To(ContainSubstring("Cluster 'my-cluster' isn't ready after 1s"))
+	})
+
+	It("Rejects the timeout without waiting", func() {
+		result := NewCommand().
+			ConfigString(config).
+			Args("hibernate", "cluster", "my-cluster", "--wait-timeout", "1h").
+			Run(ctx)
+		Expect(result.ExitCode()).ToNot(BeZero())
+		Expect(result.ErrString()).To(ContainSubstring(
+			"Option '--wait-timeout' can only be used together with '--wait'",
+		))
+	})
+})